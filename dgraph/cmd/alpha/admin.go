@@ -18,10 +18,12 @@ package alpha
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"net"
 	"net/http"
 
+	"github.com/dgraph-io/dgraph/edgraph"
 	"github.com/dgraph-io/dgraph/x"
 )
 
@@ -40,6 +42,45 @@ func handlerInit(w http.ResponseWriter, r *http.Request, allowedMethods map[stri
 	return true
 }
 
+// adminHandler wraps the handler of the /admin endpoint h, so that it only serves GET and POST
+// requests from whitelisted IPs that are authorized by adminAuthorized.
+func adminHandler(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !handlerInit(w, r, map[string]bool{
+			http.MethodPost: true,
+			http.MethodGet:  true,
+		}) {
+			return
+		}
+		if !adminAuthorized(w, r) {
+			return
+		}
+		h.ServeHTTP(w, r)
+	})
+}
+
+// adminAuthorized checks that a request to the /admin endpoints has a verified TLS client
+// certificate if --admin_require_client_cert is set, and a valid access JWT if
+// --admin_require_auth is set. Returns false, after writing the error, if it doesn't.
+func adminAuthorized(w http.ResponseWriter, r *http.Request) bool {
+	if x.WorkerConfig.AdminRequireClientCert &&
+		(r.TLS == nil || len(r.TLS.VerifiedChains) == 0) {
+		x.SetHttpStatus(w, http.StatusUnauthorized,
+			"a verified TLS client certificate is required to access the admin endpoint")
+		return false
+	}
+	if x.WorkerConfig.AdminRequireAuth {
+		ctx := x.AttachAccessJwt(context.Background(), r)
+		if _, err := edgraph.UserIdFromContext(ctx); err != nil {
+			x.SetHttpStatus(w, http.StatusUnauthorized,
+				fmt.Sprintf("a valid access JWT is required to access the admin endpoint: %v",
+					err))
+			return false
+		}
+	}
+	return true
+}
+
 func ipInIPWhitelistRanges(ipString string) bool {
 	ip := net.ParseIP(ipString)
 
@@ -167,6 +167,11 @@ func queryHandler(w http.ResponseWriter, r *http.Request) {
 		x.SetStatus(w, x.ErrorInvalidRequest, err.Error())
 		return
 	}
+	strictAcl, err := parseBool(r, "strictAcl")
+	if err != nil {
+		x.SetStatus(w, x.ErrorInvalidRequest, err.Error())
+		return
+	}
 
 	body := readRequest(w, r)
 	if body == nil {
@@ -195,7 +200,13 @@ func queryHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	ctx := context.WithValue(context.Background(), query.DebugKey, isDebugMode)
+	md := metadata.New(nil)
+	if strictAcl {
+		// Fail the query if it uses predicates the user isn't authorized to read.
+		md.Append("strict-acl", "true")
+	}
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+	ctx = context.WithValue(ctx, query.DebugKey, isDebugMode)
 	ctx = x.AttachAccessJwt(ctx, r)
 
 	if queryTimeout != 0 {
@@ -586,11 +597,12 @@ func adminSchemaHandler(w http.ResponseWriter, r *http.Request, adminServer web.
 
 	gqlReq := &schema.Request{}
 	gqlReq.Query = `
-		mutation updateGqlSchema($sch: String!) {
+		mutation updateGqlSchema($sch: String!, $force: Boolean) {
 			updateGQLSchema(input: {
 				set: {
 					schema: $sch
 				}
+				force: $force
 			}) {
 				gqlSchema {
 					id
@@ -598,7 +610,8 @@ func adminSchemaHandler(w http.ResponseWriter, r *http.Request, adminServer web.
 			}
 		}`
 	gqlReq.Variables = map[string]interface{}{
-		"sch": string(b),
+		"sch":   string(b),
+		"force": r.URL.Query().Get("force") == "true",
 	}
 
 	response := adminServer.Resolve(ctx, gqlReq)
@@ -770,12 +770,9 @@ func TestDrainingMode(t *testing.T) {
 	  }
 	}
 	`
+		// Reads are still served in draining mode.
 		_, _, err := queryWithTs(q1, "application/graphql+-", "", 0)
-		if expectErr {
-			require.True(t, err != nil && strings.Contains(err.Error(), "the server is in draining mode"))
-		} else {
-			require.NoError(t, err, "Got error while running query: %v", err)
-		}
+		require.NoError(t, err, "Got error while running query: %v", err)
 
 		m1 := `
     {
@@ -798,6 +795,11 @@ func TestDrainingMode(t *testing.T) {
 			require.NoError(t, err, "Got error while running alter: %v", err)
 		}
 
+		status := "healthy"
+		if expectErr {
+			status = "draining"
+		}
+		require.Equal(t, status, adminHealthStatus(t))
 	}
 
 	setDrainingMode(t, true)
@@ -806,3 +808,84 @@ func TestDrainingMode(t *testing.T) {
 	setDrainingMode(t, false)
 	runRequests(false)
 }
+
+// adminHealthStatus returns the status of the alpha serving the health query of /admin.
+func adminHealthStatus(t *testing.T) string {
+	params := testutil.GraphQLParams{
+		Query: `query {
+			health {
+				instance
+				address
+				status
+			}
+		}`,
+	}
+	b, err := json.Marshal(params)
+	require.NoError(t, err)
+
+	resp, err := http.Post(fmt.Sprintf("%s/admin", addr), "application/json", bytes.NewBuffer(b))
+	require.NoError(t, err)
+
+	defer resp.Body.Close()
+	b, err = ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	var result struct {
+		Data struct {
+			Health []pb.HealthInfo
+		}
+	}
+	require.NoError(t, json.Unmarshal(b, &result))
+	// The other nodes are only reported with their health as seen by this alpha, so the
+	// status of this alpha is the last one with instance alpha.
+	status := ""
+	for _, node := range result.Data.Health {
+		if node.Instance == "alpha" {
+			status = node.Status
+		}
+	}
+	return status
+}
+
+func TestAdminRequireAuth(t *testing.T) {
+	oldConfig := x.WorkerConfig
+	defer func() {
+		x.WorkerConfig = oldConfig
+	}()
+
+	served := false
+	handler := adminHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		served = true
+	}))
+	healthQuery := func(accessJwt string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/admin",
+			strings.NewReader(`{"query": "{ health { status } }"}`))
+		req.RemoteAddr = "127.0.0.1:12345"
+		if accessJwt != "" {
+			req.Header.Set("X-Dgraph-AccessToken", accessJwt)
+		}
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	x.WorkerConfig.AdminRequireAuth = false
+	require.Equal(t, http.StatusOK, healthQuery("").Code)
+	require.True(t, served)
+
+	x.WorkerConfig.AdminRequireAuth = true
+	for _, accessJwt := range []string{"", "not-a-jwt"} {
+		served = false
+		rr := healthQuery(accessJwt)
+		require.Equal(t, http.StatusUnauthorized, rr.Code)
+		require.Contains(t, rr.Body.String(), "a valid access JWT is required")
+		require.False(t, served)
+	}
+
+	// Requests that aren't made over TLS have no client certificate.
+	x.WorkerConfig.AdminRequireAuth = false
+	x.WorkerConfig.AdminRequireClientCert = true
+	served = false
+	require.Equal(t, http.StatusUnauthorized, healthQuery("").Code)
+	require.False(t, served)
+}
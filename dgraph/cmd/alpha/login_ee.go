@@ -21,15 +21,15 @@ package alpha
 import (
 	"context"
 	"encoding/json"
-	"net"
 	"net/http"
-	"strconv"
 
 	"github.com/dgraph-io/dgo/v2/protos/api"
 	"github.com/dgraph-io/dgraph/edgraph"
 	"github.com/dgraph-io/dgraph/x"
 	"github.com/golang/glog"
-	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 func loginHandler(w http.ResponseWriter, r *http.Request) {
@@ -37,18 +37,8 @@ func loginHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	ctx := context.Background()
-	if ip, port, err := net.SplitHostPort(r.RemoteAddr); err == nil {
-		// add remote addr as peer info so that the remote address can be logged inside Server.Login
-		if intPort, convErr := strconv.Atoi(port); convErr == nil {
-			ctx = peer.NewContext(ctx, &peer.Peer{
-				Addr: &net.TCPAddr{
-					IP:   net.ParseIP(ip),
-					Port: intPort,
-				},
-			})
-		}
-	}
+	// add remote addr as peer info so that the remote address can be logged inside Server.Login
+	ctx := x.AttachRemoteAddr(context.Background(), r)
 
 	body := readRequest(w, r)
 	loginReq := api.LoginRequest{}
@@ -56,8 +46,24 @@ func loginHandler(w http.ResponseWriter, r *http.Request) {
 		x.SetStatusWithData(w, x.Error, err.Error())
 		return
 	}
+	// The TOTP code of users with MFA enabled isn't part of api.LoginRequest, so it's passed on
+	// in the request metadata, like gRPC clients do.
+	var secondFactor struct {
+		Code string `json:"code"`
+	}
+	if err := json.Unmarshal(body, &secondFactor); err != nil {
+		x.SetStatusWithData(w, x.Error, err.Error())
+		return
+	}
+	if secondFactor.Code != "" {
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("totp-code", secondFactor.Code))
+	}
 
 	resp, err := (&edgraph.Server{}).Login(ctx, &loginReq)
+	if status.Code(err) == codes.ResourceExhausted {
+		x.SetHttpStatus(w, http.StatusTooManyRequests, err.Error())
+		return
+	}
 	if err != nil {
 		x.SetStatusWithData(w, x.ErrorInvalidRequest, err.Error())
 		return
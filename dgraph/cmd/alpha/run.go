@@ -41,6 +41,7 @@ import (
 	"github.com/dgraph-io/dgraph/posting"
 	"github.com/dgraph-io/dgraph/schema"
 	"github.com/dgraph-io/dgraph/tok"
+	"github.com/dgraph-io/dgraph/types"
 	"github.com/dgraph-io/dgraph/worker"
 	"github.com/dgraph-io/dgraph/x"
 
@@ -51,6 +52,7 @@ import (
 	"go.opencensus.io/plugin/ocgrpc"
 	otrace "go.opencensus.io/trace"
 	"go.opencensus.io/zpages"
+	"golang.org/x/crypto/bcrypt"
 	"golang.org/x/net/trace"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
@@ -151,6 +153,7 @@ they form a Raft group and provide synchronous replication.
 
 	flag.String("acl_secret_file", "", "The file that stores the HMAC secret, "+
 		"which is used for signing the JWT and should have at least 32 ASCII characters. "+
+		"The file is read again by the rotateACLSecret mutation of the admin API. "+
 		"Enterprise feature.")
 	flag.Duration("acl_access_ttl", 6*time.Hour, "The TTL for the access jwt. "+
 		"Enterprise feature.")
@@ -158,6 +161,42 @@ they form a Raft group and provide synchronous replication.
 		"Enterprise feature.")
 	flag.Duration("acl_cache_ttl", 30*time.Second, "The interval to refresh the acl cache. "+
 		"Enterprise feature.")
+	flag.Bool("acl_case_insensitive_users", false, "If set, user names are compared "+
+		"case-insensitively. New user names are stored in lower case and logins ignore case. "+
+		"Existing user names aren't changed, and users whose names have upper case letters "+
+		"can still log in and be managed using their exact names. Enterprise feature.")
+	flag.String("acl_audit_file", "", "The file that changes to users, groups and rules made "+
+		"through the /admin endpoint are appended to. Enterprise feature.")
+	flag.String("acl_jwks_url", "", "The URL of the JSON Web Key Set of an external identity "+
+		"provider. If set, JWTs signed by the provider can be used to log in, with the groups "+
+		"in the token used as the ACL groups of the user. Enterprise feature.")
+	flag.String("acl_jwt_groups_claim", "groups", "The claim of the JWTs issued by the external "+
+		"identity provider that holds the ACL groups of the user. Enterprise feature.")
+	flag.Int("acl_bcrypt_cost", bcrypt.DefaultCost, "The bcrypt cost that passwords, including "+
+		"the passwords of ACL users, are encrypted with. Passwords stored with a lower cost are "+
+		"encrypted again when their users log in.")
+	flag.String("acl_pepper_file", "", "The file that stores the peppers, one per line, that "+
+		"passwords are keyed with before they're encrypted, so that the stored passwords can't "+
+		"be cracked without them. Passwords are encrypted with the first pepper. The others are "+
+		"earlier peppers, whose passwords are encrypted again with the first one when their users "+
+		"log in. It must be the same on every alpha.")
+	flag.Bool("acl_strict_rules", false, "If set, rules added through the /admin endpoint must "+
+		"be for predicates that exist in the schema. Enterprise feature.")
+	flag.Int("acl_login_rate", 0, "The number of password logins per minute allowed from an "+
+		"IP once it has made --acl_login_burst logins in a row. Clients behind a shared NAT "+
+		"count as a single IP. Logins aren't rate limited if it's 0. Enterprise feature.")
+	flag.Int("acl_login_burst", 10, "The number of password logins an IP can make in a row "+
+		"before --acl_login_rate applies. Enterprise feature.")
+	flag.Int("acl_user_request_rate", 0, "The number of queries and mutations per second "+
+		"allowed from an ACL user, other than a guardian, once it has made "+
+		"--acl_user_request_burst requests in a row. Each alpha limits the requests it receives "+
+		"on its own. Requests aren't rate limited if it's 0. Enterprise feature.")
+	flag.Int("acl_user_request_burst", 100, "The number of queries and mutations an ACL user "+
+		"can make in a row before --acl_user_request_rate applies. Enterprise feature.")
+	flag.Duration("acl_max_staleness", 0, "If set, queries, mutations and alters are denied "+
+		"once the acl cache hasn't been refreshed for this long, e.g. because the alpha lost "+
+		"contact with zero. Queries by guardians are still allowed. It must be longer than "+
+		"--acl_cache_ttl. Enterprise feature.")
 	flag.Float64P("lru_mb", "l", -1,
 		"Estimated memory the LRU cache can take. "+
 			"Actual usage by the process would be more than specified here.")
@@ -180,6 +219,15 @@ they form a Raft group and provide synchronous replication.
 	flag.String("tls_dir", "", "Path to directory that has TLS certificates and keys.")
 	flag.Bool("tls_use_system_ca", true, "Include System CA into CA Certs.")
 	flag.String("tls_client_auth", "VERIFYIFGIVEN", "Enable TLS client authentication")
+	flag.Bool("admin_require_auth", false, "If set, every request to the /admin endpoint, "+
+		"including health queries, must carry a valid access JWT in the X-Dgraph-AccessToken "+
+		"header. The JWT can be obtained from the /login endpoint. Requires ACL.")
+	flag.Bool("admin_require_client_cert", false, "If set, requests to the /admin endpoint must "+
+		"be made over TLS with a client certificate that's verified against the CA in --tls_dir. "+
+		"Requires --tls_dir.")
+	flag.Bool("admin_read_only", false, "If set, the /admin endpoint only serves queries, such "+
+		"as health, getGQLSchema and introspection, and rejects all mutations, including schema "+
+		"updates through /admin/schema.")
 
 	//Custom plugins.
 	flag.String("custom_tokenizers", "",
@@ -189,6 +237,9 @@ they form a Raft group and provide synchronous replication.
 	grpc.EnableTracing = false
 
 	flag.Bool("graphql_introspection", true, "Set to false for no GraphQL schema introspection")
+	flag.String("graphql_schema_url_hosts", "", "Comma separated list of the hosts that the "+
+		"updateGQLSchemaFromURL mutation of the admin API can fetch GraphQL schemas from. "+
+		"If empty, schemas can't be fetched from URLs.")
 
 }
 
@@ -305,7 +356,13 @@ func healthCheck(w http.ResponseWriter, r *http.Request) {
 
 	_, ok := r.URL.Query()["live"]
 	if !ok {
-		if err := x.HealthCheck(); err != nil {
+		// A draining alpha reports itself unhealthy, so that load balancers stop sending it
+		// new requests.
+		err = x.HealthCheck()
+		if err == nil {
+			err = x.DrainingCheck()
+		}
+		if err != nil {
 			w.WriteHeader(http.StatusServiceUnavailable)
 			_, err = w.Write([]byte(err.Error()))
 			if err != nil {
@@ -451,19 +508,11 @@ func setupServer(closer *y.Closer) {
 	mainServer, adminServer := admin.NewServers(introspection, closer)
 	http.Handle("/graphql", mainServer.HTTPHandler())
 
-	whitelist := func(h http.Handler) http.Handler {
-		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			if !handlerInit(w, r, map[string]bool{
-				http.MethodPost: true,
-				http.MethodGet:  true,
-			}) {
-				return
-			}
-			h.ServeHTTP(w, r)
-		})
-	}
-	http.Handle("/admin", whitelist(adminServer.HTTPHandler()))
+	http.Handle("/admin", adminHandler(adminServer.HTTPHandler()))
 	http.HandleFunc("/admin/schema", func(w http.ResponseWriter, r *http.Request) {
+		if !adminAuthorized(w, r) {
+			return
+		}
 		adminSchemaHandler(w, r, adminServer)
 	})
 
@@ -526,6 +575,26 @@ func run() {
 		glog.Fatalf("Cannot enable encryption: %s", x.ErrNotSupported)
 	}
 
+	if err := types.SetBcryptCost(Alpha.Conf.GetInt("acl_bcrypt_cost")); err != nil {
+		glog.Fatalf("Invalid --acl_bcrypt_cost: %v", err)
+	}
+	if pepperFile := Alpha.Conf.GetString("acl_pepper_file"); pepperFile != "" {
+		b, err := ioutil.ReadFile(pepperFile)
+		if err != nil {
+			glog.Fatalf("Unable to read the peppers from file %s: %v", pepperFile, err)
+		}
+		var peppers []string
+		for _, line := range strings.Split(string(b), "\n") {
+			if pepper := strings.TrimSpace(line); pepper != "" {
+				peppers = append(peppers, pepper)
+			}
+		}
+		if len(peppers) == 0 {
+			glog.Fatalf("The pepper file %s is empty", pepperFile)
+		}
+		x.Check(types.SetPeppers(peppers))
+	}
+
 	secretFile := Alpha.Conf.GetString("acl_secret_file")
 	if secretFile != "" {
 		hmacSecret, err := ioutil.ReadFile(secretFile)
@@ -537,13 +606,31 @@ func run() {
 		}
 
 		opts.HmacSecret = hmacSecret
+		opts.AclSecretFile = secretFile
 		opts.AccessJwtTtl = Alpha.Conf.GetDuration("acl_access_ttl")
 		opts.RefreshJwtTtl = Alpha.Conf.GetDuration("acl_refresh_ttl")
 		opts.AclRefreshInterval = Alpha.Conf.GetDuration("acl_cache_ttl")
+		opts.AclCaseInsensitiveUsers = Alpha.Conf.GetBool("acl_case_insensitive_users")
+		opts.AclAuditFile = Alpha.Conf.GetString("acl_audit_file")
+		opts.AclJwksUrl = Alpha.Conf.GetString("acl_jwks_url")
+		opts.AclJwtGroupsClaim = Alpha.Conf.GetString("acl_jwt_groups_claim")
+		opts.AclStrictRules = Alpha.Conf.GetBool("acl_strict_rules")
+		opts.AclLoginRate = Alpha.Conf.GetInt("acl_login_rate")
+		opts.AclLoginBurst = Alpha.Conf.GetInt("acl_login_burst")
+		opts.AclUserRequestRate = Alpha.Conf.GetInt("acl_user_request_rate")
+		opts.AclUserRequestBurst = Alpha.Conf.GetInt("acl_user_request_burst")
+		opts.AclMaxStaleness = Alpha.Conf.GetDuration("acl_max_staleness")
+		if opts.AclMaxStaleness != 0 && opts.AclMaxStaleness <= opts.AclRefreshInterval {
+			glog.Fatalf("--acl_max_staleness must be longer than --acl_cache_ttl")
+		}
 
 		glog.Info("HMAC secret loaded successfully.")
 	}
 
+	if hosts := Alpha.Conf.GetString("graphql_schema_url_hosts"); hosts != "" {
+		opts.GraphqlSchemaURLHosts = strings.Split(hosts, ",")
+	}
+
 	switch strings.ToLower(Alpha.Conf.GetString("mutations")) {
 	case "allow":
 		opts.MutationsMode = worker.AllowMutations
@@ -578,6 +665,16 @@ func run() {
 		SnapshotAfter:       Alpha.Conf.GetInt("snapshot_after"),
 		AbortOlderThan:      abortDur,
 		StartTime:           startTime,
+
+		AdminRequireAuth:       Alpha.Conf.GetBool("admin_require_auth"),
+		AdminRequireClientCert: Alpha.Conf.GetBool("admin_require_client_cert"),
+		AdminReadOnly:          Alpha.Conf.GetBool("admin_read_only"),
+	}
+	if x.WorkerConfig.AdminRequireAuth && !x.WorkerConfig.AclEnabled {
+		glog.Fatalf("--admin_require_auth requires ACL to be enabled with --acl_secret_file")
+	}
+	if x.WorkerConfig.AdminRequireClientCert && Alpha.Conf.GetString("tls_dir") == "" {
+		glog.Fatalf("--admin_require_client_cert requires TLS to be enabled with --tls_dir")
 	}
 
 	setupCustomTokenizers()
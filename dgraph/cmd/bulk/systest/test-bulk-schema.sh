@@ -193,11 +193,17 @@ EOF
   diff <(LC_ALL=C sort all_dbs.out | uniq -c) - <<EOF
       1 dgraph.acl.rule
       1 dgraph.graphql.schema
+      1 dgraph.graphql.schema_updated_at
+      1 dgraph.group.default_permission
       1 dgraph.password
+      1 dgraph.rule.deny
+      1 dgraph.rule.filter
       1 dgraph.rule.permission
       1 dgraph.rule.predicate
       1 dgraph.type
+      1 dgraph.user.disabled
       1 dgraph.user.group
+      1 dgraph.user.totp
       1 dgraph.xid
       1 genre
       1 language
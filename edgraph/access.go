@@ -40,6 +40,27 @@ func (s *Server) Login(ctx context.Context,
 	return &api.Response{}, x.ErrNotSupported
 }
 
+// LoginWithExternalJwt handles login requests with a jwt issued by an external identity provider.
+// This version rejects all requests since ACL is only supported in the enterprise version.
+func (s *Server) LoginWithExternalJwt(ctx context.Context,
+	externalJwt string) (*api.Response, error) {
+	if err := x.HealthCheck(); err != nil {
+		return nil, err
+	}
+
+	glog.Warningf("Login failed: %s", x.ErrNotSupported)
+	return &api.Response{}, x.ErrNotSupported
+}
+
+// Impersonate rejects all requests since ACL is only supported in the enterprise version.
+func (s *Server) Impersonate(ctx context.Context, userId string) (*api.Response, error) {
+	if err := x.HealthCheck(); err != nil {
+		return nil, err
+	}
+
+	return &api.Response{}, x.ErrNotSupported
+}
+
 // ResetAcl is an empty method since ACL is only supported in the enterprise version.
 func ResetAcl() {
 	// do nothing
@@ -65,7 +86,75 @@ func authorizeQuery(ctx context.Context, parsedReq *gql.Result, graphql bool) er
 	return nil
 }
 
+func limitUserRequests(ctx context.Context) error {
+	return nil
+}
+
 func authorizeGroot(ctx context.Context) error {
 	// always allow access
 	return nil
 }
+
+// RotateAclSecret returns ErrNotSupported since ACL is only supported in the enterprise version.
+func RotateAclSecret() error {
+	return x.ErrNotSupported
+}
+
+// EnableTotp returns ErrNotSupported since ACL is only supported in the enterprise version.
+func EnableTotp(ctx context.Context, userId string) (string, error) {
+	return "", x.ErrNotSupported
+}
+
+// AclHealth reports ACL as disabled since ACL is only supported in the enterprise version.
+func AclHealth() *AclStatus {
+	return &AclStatus{}
+}
+
+// AclCache returns an empty cache since ACL is only supported in the enterprise version.
+func AclCache() *AclCacheContents {
+	return &AclCacheContents{}
+}
+
+// ReadablePredicates returns no predicates since ACL is only supported in the enterprise version.
+func ReadablePredicates(userId string, groupIds, schemaPreds []string) []string {
+	return nil
+}
+
+// EvaluateAccess returns ErrNotSupported since ACL is only supported in the enterprise version.
+func EvaluateAccess(group string, rules []AclCacheRule, query string) (*AccessEvaluation, error) {
+	return nil, x.ErrNotSupported
+}
+
+// AuthorizeGuardians authorizes the operation for users which belong to the guardians group.
+func AuthorizeGuardians(ctx context.Context) error {
+	// always allow access
+	return nil
+}
+
+// AuthorizeAclReaders authorizes the operation for users which can read the ACL data.
+func AuthorizeAclReaders(ctx context.Context) error {
+	// always allow access
+	return nil
+}
+
+// NormalizeUserId returns the user id as it is, as user names are only normalized when ACL is
+// enabled.
+func NormalizeUserId(userId string) string {
+	return userId
+}
+
+// ValidateGroupName accepts every name, as there are no groups without ACL.
+func ValidateGroupName(name string) error {
+	return nil
+}
+
+// ResolveUserId returns the user id as it is, as user names are only normalized when ACL is
+// enabled.
+func ResolveUserId(ctx context.Context, userId string) (string, error) {
+	return userId, nil
+}
+
+// UserIdFromContext returns an empty user id, as there are no users without ACL.
+func UserIdFromContext(ctx context.Context) (string, error) {
+	return "", nil
+}
@@ -16,6 +16,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -27,11 +29,13 @@ import (
 	"github.com/dgraph-io/dgraph/ee/acl"
 	"github.com/dgraph-io/dgraph/gql"
 	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/types"
 	"github.com/dgraph-io/dgraph/worker"
 	"github.com/dgraph-io/dgraph/x"
 	jwt "github.com/dgrijalva/jwt-go"
 	"github.com/golang/glog"
 	otrace "go.opencensus.io/trace"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/peer"
@@ -64,6 +68,13 @@ func (s *Server) Login(ctx context.Context,
 		}, "client ip for login")
 	}
 
+	// Logins with a refresh token don't check a password, so they aren't rate limited.
+	if len(request.RefreshToken) == 0 && addr != "" && !allowLogin(addr) {
+		glog.Warningf("Login request from %s was throttled", addr)
+		return nil, status.Errorf(codes.ResourceExhausted,
+			"too many login attempts from %s, try again later", addr)
+	}
+
 	user, err := s.authenticateLogin(ctx, request)
 	if err != nil {
 		errMsg := fmt.Sprintf("Authentication from address %s failed: %v", addr, err)
@@ -72,6 +83,12 @@ func (s *Server) Login(ctx context.Context,
 	}
 	glog.Infof("%s logged in successfully", user.UserID)
 
+	if len(request.RefreshToken) == 0 {
+		if err := rehashPassword(ctx, user, request.Password); err != nil {
+			glog.Errorf("Unable to rehash the password of %s: %v", user.UserID, err)
+		}
+	}
+
 	resp := &api.Response{}
 	accessJwt, err := getAccessJwt(user.UserID, user.Groups)
 	if err != nil {
@@ -104,6 +121,89 @@ func (s *Server) Login(ctx context.Context,
 	return resp, nil
 }
 
+// Impersonate returns an access jwt that carries the groups of the user with the given id, so
+// that a guardian can run queries with the same permissions as that user. No refresh jwt is
+// returned, so the impersonated session ends when the access jwt expires.
+func (s *Server) Impersonate(ctx context.Context, userId string) (*api.Response, error) {
+	if err := x.HealthCheck(); err != nil {
+		return nil, err
+	}
+
+	if !worker.EnterpriseEnabled() {
+		return nil, errors.New("Enterprise features are disabled. You can enable them by " +
+			"supplying the appropriate license file to Dgraph Zero using the HTTP endpoint.")
+	}
+
+	if err := AuthorizeGuardians(ctx); err != nil {
+		return nil, err
+	}
+	guardian, err := UserIdFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userId, err = ResolveUserId(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	user, err := authorizeUser(ctx, userId, "")
+	if err != nil {
+		return nil, errors.Wrapf(err, "while querying user with id %v", userId)
+	}
+	if user == nil {
+		return nil, errors.Errorf("unable to impersonate: user not found for id %v", userId)
+	}
+	glog.Infof("%s is impersonating %s", guardian, user.UserID)
+
+	accessJwt, err := getAccessJwt(user.UserID, user.Groups)
+	if err != nil {
+		return nil, errors.Errorf("unable to get access jwt (userid=%s):%v", user.UserID, err)
+	}
+
+	loginJwt := api.Jwt{
+		AccessJwt: accessJwt,
+	}
+	jwtBytes, err := loginJwt.Marshal()
+	if err != nil {
+		return nil, errors.Errorf("unable to marshal jwt (userid=%s):%v", user.UserID, err)
+	}
+	return &api.Response{Json: jwtBytes}, nil
+}
+
+// rehashPassword encrypts the password of user again if the stored one has a different bcrypt
+// cost than the one set by --acl_bcrypt_cost, or wasn't keyed with the current pepper of
+// --acl_pepper_file. The stored password can only be read by the alphas that serve it, so the
+// others leave it as it is. It's also left as it is while the alpha is draining.
+func rehashPassword(ctx context.Context, user *acl.User, password string) error {
+	if x.IsDraining() {
+		return nil
+	}
+	uid, err := strconv.ParseUint(user.Uid, 0, 64)
+	if err != nil {
+		return errors.Wrapf(err, "while parsing the uid of %s", user.UserID)
+	}
+	encrypted, ok, err := worker.StoredPassword("dgraph.password", uid)
+	if err != nil || !ok || !types.NeedsRehash(password, encrypted) {
+		return err
+	}
+
+	req := &api.Request{
+		CommitNow: true,
+		Mutations: []*api.Mutation{{
+			Set: []*api.NQuad{{
+				Subject:     user.Uid,
+				Predicate:   "dgraph.password",
+				ObjectValue: &api.Value{Val: &api.Value_StrVal{StrVal: password}},
+			}},
+		}},
+	}
+	if _, err := (&Server{}).doQuery(ctx, req, NoAuthorize); err != nil {
+		return err
+	}
+	glog.Infof("Rehashed the password of %s with bcrypt cost %d", user.UserID, types.BcryptCost())
+	return nil
+}
+
 // authenticateLogin authenticates the login request using either the refresh token if present, or
 // the <userId, password> pair. If authentication passes, it queries the user's uid and associated
 // groups from DB and returns the user object
@@ -131,14 +231,20 @@ func (s *Server) authenticateLogin(ctx context.Context, request *api.LoginReques
 			return nil, errors.Errorf("unable to authenticate through refresh token: "+
 				"user not found for id %v", userId)
 		}
+		if user.Disabled {
+			return nil, errors.Errorf("user %v is disabled", userId)
+		}
 
 		glog.Infof("Authenticated user %s through refresh token", userId)
 		return user, nil
 	}
 
 	// authorize the user using password
-	var err error
-	user, err = authorizeUser(ctx, request.Userid, request.Password)
+	userId, err := ResolveUserId(ctx, request.Userid)
+	if err != nil {
+		return nil, err
+	}
+	user, err = authorizeUser(ctx, userId, request.Password)
 	if err != nil {
 		return nil, errors.Wrapf(err, "while querying user with id %v",
 			request.Userid)
@@ -151,6 +257,14 @@ func (s *Server) authenticateLogin(ctx context.Context, request *api.LoginReques
 	if !user.PasswordMatch {
 		return nil, errors.Errorf("password mismatch for user: %v", request.Userid)
 	}
+	if user.Disabled {
+		return nil, errors.Errorf("user %v is disabled", request.Userid)
+	}
+	// A refresh token is only issued after the second factor has been checked, so it's only
+	// checked when logging in with a password.
+	if err := checkSecondFactor(ctx, user); err != nil {
+		return nil, err
+	}
 	return user, nil
 }
 
@@ -158,12 +272,21 @@ func (s *Server) authenticateLogin(ctx context.Context, request *api.LoginReques
 // returns a slice of strings, where the first element is the extracted userId
 // and the rest are groupIds encoded in the jwt.
 func validateToken(jwtStr string) ([]string, error) {
-	token, err := jwt.Parse(jwtStr, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, errors.Errorf("unexpected signing method: %v", token.Header["alg"])
+	var token *jwt.Token
+	var err error
+	// The token may have been signed with a secret that has since been rotated.
+	for _, secret := range hmacSecretsPtr.verificationSecrets() {
+		secret := secret
+		token, err = jwt.Parse(jwtStr, func(token *jwt.Token) (interface{}, error) {
+			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+				return nil, errors.Errorf("unexpected signing method: %v", token.Header["alg"])
+			}
+			return secret, nil
+		})
+		if err == nil {
+			break
 		}
-		return worker.Config.HmacSecret, nil
-	})
+	}
 
 	if err != nil {
 		return nil, errors.Errorf("unable to parse jwt token:%v", err)
@@ -234,7 +357,7 @@ func getAccessJwt(userId string, groups []acl.Group) (string, error) {
 		"exp": time.Now().Add(worker.Config.AccessJwtTtl).Unix(),
 	})
 
-	jwtString, err := token.SignedString(worker.Config.HmacSecret)
+	jwtString, err := token.SignedString(hmacSecretsPtr.signingSecret())
 	if err != nil {
 		return "", errors.Errorf("unable to encode jwt to string: %v", err)
 	}
@@ -249,7 +372,7 @@ func getRefreshJwt(userId string) (string, error) {
 		"exp":    time.Now().Add(worker.Config.RefreshJwtTtl).Unix(),
 	})
 
-	jwtString, err := token.SignedString(worker.Config.HmacSecret)
+	jwtString, err := token.SignedString(hmacSecretsPtr.signingSecret())
 	if err != nil {
 		return "", errors.Errorf("unable to encode jwt to string: %v", err)
 	}
@@ -262,6 +385,8 @@ const queryUser = `
 	    uid
         dgraph.xid
         password_match: checkpwd(dgraph.password, $password)
+        dgraph.user.disabled
+        dgraph.user.totp
         dgraph.user.group {
           uid
           dgraph.xid
@@ -325,8 +450,24 @@ func RefreshAcls(closer *y.Closer) {
 		if err != nil {
 			return err
 		}
+		users, err := acl.UnmarshalGroups(queryResp.GetJson(), "userAcls")
+		if err != nil {
+			return err
+		}
+		groups = ruleSets(groups, users)
+		var userCount struct {
+			AllUsers []struct {
+				Count int `json:"count"`
+			} `json:"allUsers"`
+		}
+		if err := json.Unmarshal(queryResp.GetJson(), &userCount); err != nil {
+			return errors.Wrapf(err, "unable to unmarshal the number of users")
+		}
 
 		aclCachePtr.update(groups)
+		if len(userCount.AllUsers) > 0 {
+			aclCachePtr.updateUserCount(userCount.AllUsers[0].Count)
+		}
 		glog.V(3).Infof("Updated the ACL cache")
 		return nil
 	}
@@ -346,12 +487,27 @@ func RefreshAcls(closer *y.Closer) {
 const queryAcls = `
 {
   allAcls(func: type(Group)) {
+    dgraph.xid
+    dgraph.group.default_permission
+	dgraph.acl.rule {
+		dgraph.rule.predicate
+		dgraph.rule.permission
+		dgraph.rule.deny
+		dgraph.rule.filter
+	}
+  }
+  userAcls(func: type(User)) @filter(has(dgraph.acl.rule)) {
     dgraph.xid
 	dgraph.acl.rule {
 		dgraph.rule.predicate
 		dgraph.rule.permission
+		dgraph.rule.deny
+		dgraph.rule.filter
 	}
   }
+  allUsers(func: type(User)) {
+	count(uid)
+  }
 }
 `
 
@@ -362,14 +518,15 @@ func ResetAcl() {
 		return
 	}
 
-	// guardians is the group of users who have complete access over all predicates.
-	upsertGuardians := func(ctx context.Context) error {
+	// guardians is the group of users who have complete access over all predicates, and
+	// readonly-guardians is the group of users who can read, but not modify, the ACL data.
+	upsertGroup := func(ctx context.Context, groupId string) error {
 		query := fmt.Sprintf(`
 			{
 				guid as var(func: eq(dgraph.xid, "%s"))
 			}
-		`, x.GuardiansId)
-		groupNQuads := acl.CreateGroupNQuads(x.GuardiansId)
+		`, groupId)
+		groupNQuads := acl.CreateGroupNQuads(groupId)
 		req := &api.Request{
 			CommitNow: true,
 			Query:     query,
@@ -382,10 +539,10 @@ func ResetAcl() {
 		}
 
 		if _, err := (&Server{}).doQuery(ctx, req, NoAuthorize); err != nil {
-			return errors.Wrapf(err, "while upserting group with id %s", x.GuardiansId)
+			return errors.Wrapf(err, "while upserting group with id %s", groupId)
 		}
 
-		glog.Infof("Successfully upserted the guardian group")
+		glog.Infof("Successfully upserted the %s group", groupId)
 		return nil
 	}
 
@@ -423,15 +580,17 @@ func ResetAcl() {
 		return nil
 	}
 
-	for {
-		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
-		defer cancel()
-		if err := upsertGuardians(ctx); err != nil {
-			glog.Infof("Unable to upsert the guardian group. Error: %v", err)
-			time.Sleep(100 * time.Millisecond)
-			continue
+	for _, groupId := range []string{x.GuardiansId, x.ReadonlyGuardiansId} {
+		for {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+			defer cancel()
+			if err := upsertGroup(ctx, groupId); err != nil {
+				glog.Infof("Unable to upsert the %s group. Error: %v", groupId, err)
+				time.Sleep(100 * time.Millisecond)
+				continue
+			}
+			break
 		}
-		break
 	}
 
 	for {
@@ -463,12 +622,27 @@ func extractUserAndGroups(ctx context.Context) ([]string, error) {
 	return validateToken(accessJwt[0])
 }
 
+// ruleHolders returns the keys of the cached rules that apply to the user with the given id and
+// groups, which are the groups and the key of the rules set directly on the user. Groups that
+// look like the key of the rules of a user are left out, so that those rules can't be gained
+// through a group name.
+func ruleHolders(userId string, groupIds []string) []string {
+	holders := make([]string, 0, len(groupIds)+1)
+	for _, group := range groupIds {
+		if !isUserRulesKey(group) {
+			holders = append(holders, group)
+		}
+	}
+	return append(holders, userRulesKey(userId))
+}
+
 func authorizePreds(userId string, groupIds, preds []string,
 	aclOp *acl.Operation) map[string]struct{} {
 
+	holders := ruleHolders(userId, groupIds)
 	blockedPreds := make(map[string]struct{})
 	for _, pred := range preds {
-		if err := aclCachePtr.authorizePredicate(groupIds, pred, aclOp); err != nil {
+		if err := aclCachePtr.authorizePredicate(holders, pred, aclOp); err != nil {
 			logAccess(&accessEntry{
 				userId:    userId,
 				groups:    groupIds,
@@ -483,6 +657,39 @@ func authorizePreds(userId string, groupIds, preds []string,
 	return blockedPreds
 }
 
+// permissionDenied returns a PermissionDenied error with the message msg. Its details have a
+// PreconditionFailure with a violation for each of the blocked predicates, which names the
+// predicate and the denied operation. The groups whose rules deny the operation or would grant
+// it are only logged, as they would reveal the ACL rules to the user they restrict.
+func permissionDenied(userId string, groupIds []string, blockedPreds map[string]struct{},
+	aclOp *acl.Operation, msg string) error {
+
+	preds := make([]string, 0, len(blockedPreds))
+	for pred := range blockedPreds {
+		preds = append(preds, pred)
+	}
+	sort.Strings(preds)
+
+	failure := &errdetails.PreconditionFailure{}
+	for _, pred := range preds {
+		failure.Violations = append(failure.Violations, &errdetails.PreconditionFailure_Violation{
+			Type:        aclOp.Name,
+			Subject:     pred,
+			Description: fmt.Sprintf("%s access to %s is denied", aclOp.Name, pred),
+		})
+		glog.Infof("User %s was denied %s access to %s: %s", userId, aclOp.Name, pred,
+			aclCachePtr.describeDenial(groupIds, pred, aclOp))
+	}
+
+	st := status.New(codes.PermissionDenied, msg)
+	withDetails, err := st.WithDetails(failure)
+	if err != nil {
+		glog.Errorf("Unable to add details to permission denied error: %v", err)
+		return st.Err()
+	}
+	return withDetails.Err()
+}
+
 // authorizeAlter parses the Schema in the operation and authorizes the operation
 // using the aclCachePtr. It will return error if any one of the predicates specified in alter
 // are not authorized.
@@ -525,6 +732,9 @@ func authorizeAlter(ctx context.Context, op *api.Operation) error {
 		userId = userData[0]
 		groupIds = userData[1:]
 
+		if err := aclCachePtr.checkStale(time.Now(), worker.Config.AclMaxStaleness); err != nil {
+			return err
+		}
 		if x.IsGuardian(groupIds) {
 			// Members of guardian group are allowed to alter anything.
 			return nil
@@ -543,8 +753,9 @@ func authorizeAlter(ctx context.Context, op *api.Operation) error {
 				x.Check2(msg.WriteString(key))
 				x.Check2(msg.WriteString(" "))
 			}
-			return status.Errorf(codes.PermissionDenied,
-				"unauthorized to alter following predicates: %s\n", msg.String())
+			return permissionDenied(userId, ruleHolders(userId, groupIds), blockedPreds,
+				acl.Modify,
+				fmt.Sprintf("unauthorized to alter following predicates: %s\n", msg.String()))
 		}
 		return nil
 	}
@@ -632,6 +843,9 @@ func authorizeMutation(ctx context.Context, gmu *gql.Mutation) error {
 		userId = userData[0]
 		groupIds = userData[1:]
 
+		if err := aclCachePtr.checkStale(time.Now(), worker.Config.AclMaxStaleness); err != nil {
+			return err
+		}
 		if x.IsGuardian(groupIds) {
 			// Members of guardians group are allowed to mutate anything
 			// (including delete) except the permission of the acl predicates.
@@ -651,8 +865,9 @@ func authorizeMutation(ctx context.Context, gmu *gql.Mutation) error {
 				x.Check2(msg.WriteString(key))
 				x.Check2(msg.WriteString(" "))
 			}
-			return status.Errorf(codes.PermissionDenied,
-				"unauthorized to mutate following predicates: %s\n", msg.String())
+			return permissionDenied(userId, ruleHolders(userId, groupIds), blockedPreds,
+				acl.Write,
+				fmt.Sprintf("unauthorized to mutate following predicates: %s\n", msg.String()))
 		}
 
 		return nil
@@ -737,8 +952,24 @@ func logAccess(log *accessEntry) {
 	}
 }
 
+// strictAclRequested returns whether the strict-acl key of the request metadata is set to true,
+// in which case queries that use unauthorized predicates fail instead of having them removed.
+func strictAclRequested(ctx context.Context) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false
+	}
+	values := md.Get("strict-acl")
+	if len(values) == 0 {
+		return false
+	}
+	strict, _ := strconv.ParseBool(values[0])
+	return strict
+}
+
 //authorizeQuery authorizes the query using the aclCachePtr. It will silently drop all
-// unauthorized predicates from query.
+// unauthorized predicates from query, unless strict ACL is requested through the strict-acl key
+// of the request metadata, in which case the query is denied.
 func authorizeQuery(ctx context.Context, parsedReq *gql.Result, graphql bool) error {
 	if len(worker.Config.HmacSecret) == 0 {
 		// the user has not turned on the acl feature
@@ -759,9 +990,13 @@ func authorizeQuery(ctx context.Context, parsedReq *gql.Result, graphql bool) er
 		groupIds = userData[1:]
 
 		if x.IsGuardian(groupIds) {
-			// Members of guardian groups are allowed to query anything.
+			// Members of guardian groups are allowed to query anything, even if the ACL cache
+			// is stale, so that they can look into why it isn't refreshed.
 			return nil, nil
 		}
+		if err := aclCachePtr.checkStale(time.Now(), worker.Config.AclMaxStaleness); err != nil {
+			return nil, err
+		}
 
 		return authorizePreds(userId, groupIds, preds, acl.Read), nil
 	}
@@ -785,9 +1020,12 @@ func authorizeQuery(ctx context.Context, parsedReq *gql.Result, graphql bool) er
 	if len(blockedPreds) != 0 {
 		// For GraphQL requests, we allow filtered access to the ACL predicates.
 		// Filter for user_id and group_id is applied for the currently logged in user.
+		// Members of the readonly guardians group can read the ACL data of all users.
 		if graphql {
-			for _, gq := range parsedReq.Query {
-				addUserFilterToQuery(gq, userId, groupIds)
+			if !x.IsReadonlyGuardian(groupIds) {
+				for _, gq := range parsedReq.Query {
+					addUserFilterToQuery(gq, userId, groupIds)
+				}
 			}
 			// blockedPreds might have acl predicates which we want to allow access through
 			// graphql, so deleting those from here.
@@ -796,13 +1034,114 @@ func authorizeQuery(ctx context.Context, parsedReq *gql.Result, graphql bool) er
 			}
 			// In query context ~predicate and predicate are considered different.
 			delete(blockedPreds, "~dgraph.user.group")
+		} else if strictAclRequested(ctx) {
+			return permissionDenied(userId, ruleHolders(userId, groupIds), blockedPreds,
+				acl.Read, "unauthorized to query")
 		}
 		parsedReq.Query = removePredsFromQuery(parsedReq.Query, blockedPreds)
 	}
 
+	if !x.IsGuardian(groupIds) {
+		return addRowFilters(parsedReq.Query, ruleHolders(userId, groupIds), preds)
+	}
 	return nil
 }
 
+// addRowFilters ANDs the filters of the rules that grant read access only to some nodes into the
+// query blocks that use the predicates of those rules, so that only the matching nodes are
+// returned by those blocks, or used to filter, order or group them.
+func addRowFilters(gqs []*gql.GraphQuery, groupIds, preds []string) error {
+	filters := make(map[string][]string)
+	for _, pred := range preds {
+		if predFilters := aclCachePtr.readFilters(groupIds, pred); len(predFilters) > 0 {
+			filters[pred] = predFilters
+		}
+	}
+	if len(filters) == 0 {
+		return nil
+	}
+	return addRowFiltersToQuery(gqs, filters)
+}
+
+func addRowFiltersToQuery(gqs []*gql.GraphQuery, filters map[string][]string) error {
+	for _, gq := range gqs {
+		if gq == nil {
+			continue
+		}
+
+		for _, attr := range blockPreds(gq) {
+			if len(filters[attr]) == 0 {
+				continue
+			}
+			// The nodes of @recurse and shortest path blocks are reached through the block's
+			// own edges, so a filter on the block can't restrict all of them.
+			if gq.Recurse || gq.Alias == "shortest" {
+				return status.Errorf(codes.PermissionDenied, "the rules for %s grant read "+
+					"access only to some nodes, which can't be enforced in a @recurse or "+
+					"shortest path block", attr)
+			}
+
+			// The nodes matching the filter of any of the user's groups can be read.
+			rowFilter := &gql.FilterTree{Op: "or"}
+			for _, filter := range filters[attr] {
+				tree, err := gql.ParseFilter(filter)
+				if err != nil {
+					return status.Error(codes.PermissionDenied, errors.Wrapf(err,
+						"while applying the rules for %s", attr).Error())
+				}
+				rowFilter.Child = append(rowFilter.Child, tree)
+			}
+			if len(rowFilter.Child) == 1 {
+				rowFilter = rowFilter.Child[0]
+			}
+
+			if gq.Filter == nil {
+				gq.Filter = rowFilter
+			} else {
+				gq.Filter = &gql.FilterTree{
+					Op:    "and",
+					Child: []*gql.FilterTree{gq.Filter, rowFilter},
+				}
+			}
+		}
+
+		if err := addRowFiltersToQuery(gq.Children, filters); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// blockPreds returns the predicates that the query block gq uses on its own nodes: the one its
+// root function uses, its children, and the ones it's filtered, ordered and grouped by. Each is
+// returned once.
+func blockPreds(gq *gql.GraphQuery) []string {
+	var attrs []string
+	if gq.Func != nil {
+		attrs = append(attrs, gq.Func.Attr)
+	}
+	for _, child := range gq.Children {
+		attrs = append(attrs, child.Attr)
+	}
+	attrs = append(attrs, parsePredsFromFilter(gq.Filter)...)
+	for _, order := range gq.Order {
+		attrs = append(attrs, order.Attr)
+	}
+	for _, groupBy := range gq.GroupbyAttrs {
+		attrs = append(attrs, groupBy.Attr)
+	}
+
+	seen := make(map[string]bool)
+	preds := attrs[:0]
+	for _, attr := range attrs {
+		if attr != "" && !seen[attr] {
+			seen[attr] = true
+			preds = append(preds, attr)
+		}
+	}
+	return preds
+}
+
 // authorizeGroot authorizes the operation for Groot users.
 func authorizeGroot(ctx context.Context) error {
 	if len(worker.Config.HmacSecret) == 0 {
@@ -833,6 +1172,195 @@ func authorizeGroot(ctx context.Context) error {
 	return doAuthorizeGroot()
 }
 
+// UserIdFromContext returns the id of the user whose access JWT is attached to ctx.
+func UserIdFromContext(ctx context.Context) (string, error) {
+	userData, err := extractUserAndGroups(ctx)
+	if err != nil {
+		return "", err
+	}
+	return userData[0], nil
+}
+
+// NormalizeUserId returns the form of userId that's stored in Dgraph and used to log in. User
+// ids are lower cased if case-insensitive user names are enabled.
+func NormalizeUserId(userId string) string {
+	if worker.Config.AclCaseInsensitiveUsers {
+		return strings.ToLower(userId)
+	}
+	return userId
+}
+
+// ValidateGroupName returns an error if name can't be the name of a group.
+func ValidateGroupName(name string) error {
+	return acl.ValidateGroupName(name)
+}
+
+// ResolveUserId returns the id of the existing user that userId refers to. That's the normalized
+// form of userId, unless no user has it but one has userId exactly, like users whose names had
+// upper case letters before case-insensitive user names were enabled. If neither exists, the
+// normalized form is returned.
+func ResolveUserId(ctx context.Context, userId string) (string, error) {
+	normalized := NormalizeUserId(userId)
+	if normalized == userId {
+		return userId, nil
+	}
+	for _, id := range []string{normalized, userId} {
+		user, err := authorizeUser(ctx, id, "")
+		if err != nil {
+			return "", errors.Wrapf(err, "while querying user with id %v", id)
+		}
+		if user != nil {
+			return id, nil
+		}
+	}
+	return normalized, nil
+}
+
+// AclHealth returns the state of the ACL subsystem of this alpha, as of the last refresh of the
+// ACL cache.
+func AclHealth() *AclStatus {
+	aclStatus := &AclStatus{Enabled: len(worker.Config.HmacSecret) > 0}
+	if !aclStatus.Enabled {
+		return aclStatus
+	}
+
+	aclCachePtr.RLock()
+	defer aclCachePtr.RUnlock()
+	if !aclCachePtr.lastRefresh.IsZero() {
+		aclStatus.LastCacheRefresh = aclCachePtr.lastRefresh.Unix()
+	}
+	aclStatus.GroupCount = aclCachePtr.groupCount
+	aclStatus.UserCount = aclCachePtr.userCount
+	return aclStatus
+}
+
+// AclCache returns the contents of the ACL cache of this alpha, which are what its ACL checks
+// are based on until the cache is refreshed again.
+func AclCache() *AclCacheContents {
+	return aclCachePtr.contents()
+}
+
+// ReadablePredicates returns the predicates that the user with the given id and groups can read
+// according to the rules in the ACL cache, whether through one of the groups, their default
+// permissions or a rule set on the user, in sorted order. The predicates with rules are checked
+// along with schemaPreds, which are the predicates in the schema that only default permissions
+// can grant access to. Guardians can read every predicate, so callers are expected to handle
+// them before calling it.
+func ReadablePredicates(userId string, groupIds, schemaPreds []string) []string {
+	candidates := make(map[string]struct{})
+	aclCachePtr.RLock()
+	for pred := range aclCachePtr.predPerms {
+		candidates[pred] = struct{}{}
+	}
+	aclCachePtr.RUnlock()
+	for _, pred := range schemaPreds {
+		candidates[pred] = struct{}{}
+	}
+
+	holders := ruleHolders(userId, groupIds)
+	preds := []string{}
+	for pred := range candidates {
+		if aclCachePtr.authorizePredicate(holders, pred, acl.Read) == nil {
+			preds = append(preds, pred)
+		}
+	}
+	sort.Strings(preds)
+	return preds
+}
+
+// EvaluateAccess reports which of the predicates read by query a member of group would be
+// allowed to read. If rules is nil, the rules of the group in the ACL cache are used. Otherwise,
+// the group is evaluated as if rules were its only rules, so that rules can be tried out before
+// they are added. Callers are expected to have authorized the request as coming from a guardian.
+func EvaluateAccess(group string, rules []AclCacheRule, query string) (*AccessEvaluation, error) {
+	parsed, err := gql.Parse(gql.Request{Str: query})
+	if err != nil {
+		return nil, errors.Wrapf(err, "couldn't parse query")
+	}
+
+	cache := aclCachePtr
+	if rules != nil {
+		aclRules := make([]acl.Acl, 0, len(rules))
+		for _, rule := range rules {
+			aclRules = append(aclRules, acl.Acl{
+				Predicate: rule.Predicate,
+				Perm:      rule.Permission,
+				Deny:      rule.Deny,
+				Filter:    rule.Filter,
+			})
+		}
+		cache = &aclCache{}
+		cache.update([]acl.Group{{GroupID: group, Rules: aclRules}})
+	}
+
+	groups := []string{group}
+	preds := parsePredsFromQuery(parsed.Query)
+	sort.Strings(preds)
+	eval := &AccessEvaluation{Allowed: []string{}, Denied: []string{}}
+	for _, pred := range preds {
+		// Members of guardian groups are allowed to query anything, as in authorizeQuery.
+		if x.IsGuardian(groups) || cache.authorizePredicate(groups, pred, acl.Read) == nil {
+			eval.Allowed = append(eval.Allowed, pred)
+		} else {
+			eval.Denied = append(eval.Denied, pred)
+		}
+	}
+	return eval, nil
+}
+
+// AuthorizeGuardians authorizes the operation for users which belong to the guardians group.
+func AuthorizeGuardians(ctx context.Context) error {
+	if len(worker.Config.HmacSecret) == 0 {
+		// the user has not turned on the acl feature
+		return nil
+	}
+
+	userData, err := extractUserAndGroups(ctx)
+	switch {
+	case err == errNoJwt:
+		return status.Error(codes.PermissionDenied, err.Error())
+	case err != nil:
+		return status.Error(codes.Unauthenticated, err.Error())
+	default:
+		userId := userData[0]
+		groupIds := userData[1:]
+		if !x.IsGuardian(groupIds) {
+			// Deny users which aren't members of the guardians group.
+			return status.Error(codes.PermissionDenied, fmt.Sprintf("Only guardians are "+
+				"allowed access. User '%v' isn't a member of the guardians group.", userId))
+		}
+	}
+
+	return nil
+}
+
+// AuthorizeAclReaders authorizes the operation for users which belong to the guardians group or
+// to the readonly guardians group, i.e. for users which can read the ACL data.
+func AuthorizeAclReaders(ctx context.Context) error {
+	if len(worker.Config.HmacSecret) == 0 {
+		// the user has not turned on the acl feature
+		return nil
+	}
+
+	userData, err := extractUserAndGroups(ctx)
+	switch {
+	case err == errNoJwt:
+		return status.Error(codes.PermissionDenied, err.Error())
+	case err != nil:
+		return status.Error(codes.Unauthenticated, err.Error())
+	default:
+		userId := userData[0]
+		groupIds := userData[1:]
+		if !x.IsGuardian(groupIds) && !x.IsReadonlyGuardian(groupIds) {
+			return status.Error(codes.PermissionDenied, fmt.Sprintf("Only guardians and "+
+				"readonly guardians are allowed access. User '%v' isn't a member of the %s or "+
+				"%s groups.", userId, x.GuardiansId, x.ReadonlyGuardiansId))
+		}
+	}
+
+	return nil
+}
+
 /*
 	addUserFilterToQuery applies makes sure that a user can access only its own
 	acl info by applying filter of userid and groupid to acl predicates. A query like
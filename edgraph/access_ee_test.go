@@ -0,0 +1,366 @@
+// +build !oss
+
+/*
+ * Copyright 2020 Dgraph Labs, Inc. All rights reserved.
+ *
+ * Licensed under the Dgraph Community License (the "License"); you
+ * may not use this file except in compliance with the License. You
+ * may obtain a copy of the License at
+ *
+ *     https://github.com/dgraph-io/dgraph/blob/master/licenses/DCL.txt
+ */
+
+package edgraph
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dgraph-io/dgo/v2/protos/api"
+	"github.com/dgraph-io/dgraph/ee/acl"
+	"github.com/dgraph-io/dgraph/gql"
+	"github.com/dgraph-io/dgraph/worker"
+	"github.com/dgraph-io/dgraph/x"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestPermissionDeniedDetails(t *testing.T) {
+	aclCachePtr = &aclCache{
+		predPerms: make(map[string]map[string]int32),
+	}
+	aclCachePtr.update([]acl.Group{
+		{
+			GroupID: "dev",
+			Rules:   []acl.Acl{{Predicate: "name", Perm: acl.Read.Code}},
+		},
+		{
+			GroupID: "sre",
+			Rules:   []acl.Acl{{Predicate: "name", Perm: acl.Write.Code}},
+		},
+		{
+			GroupID: "quarantine",
+			Rules:   []acl.Acl{{Predicate: "nickname", Perm: acl.Write.Code, Deny: true}},
+		},
+	})
+
+	groups := []string{"dev", "quarantine"}
+	blockedPreds := authorizePreds("alice", groups, []string{"name", "nickname"}, acl.Write)
+	err := permissionDenied("alice", groups, blockedPreds, acl.Write, "unauthorized to mutate")
+
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	require.Equal(t, codes.PermissionDenied, st.Code())
+	require.Contains(t, err.Error(), "PermissionDenied")
+	require.Contains(t, err.Error(), "unauthorized to mutate")
+
+	require.Len(t, st.Details(), 1)
+	failure, ok := st.Details()[0].(*errdetails.PreconditionFailure)
+	require.True(t, ok)
+	require.Len(t, failure.Violations, 2)
+	require.Equal(t, acl.Write.Name, failure.Violations[0].Type)
+	require.Equal(t, "name", failure.Violations[0].Subject)
+	require.Equal(t, "Write access to name is denied", failure.Violations[0].Description)
+	require.Equal(t, acl.Write.Name, failure.Violations[1].Type)
+	require.Equal(t, "nickname", failure.Violations[1].Subject)
+	require.Equal(t, "Write access to nickname is denied", failure.Violations[1].Description)
+
+	// The groups that would grant or deny access are only logged, as they reveal the rules.
+	for _, group := range []string{"sre", "quarantine"} {
+		require.NotContains(t, err.Error(), group)
+		for _, violation := range failure.Violations {
+			require.NotContains(t, violation.Description, group)
+		}
+	}
+	require.Equal(t, "Write access requires membership in one of the groups: sre",
+		aclCachePtr.describeDenial(groups, "name", acl.Write))
+	require.Equal(t, "Write is denied by the rules of the groups: quarantine",
+		aclCachePtr.describeDenial(groups, "nickname", acl.Write))
+}
+
+func TestAddRowFilters(t *testing.T) {
+	aclCachePtr = &aclCache{
+		predPerms: make(map[string]map[string]int32),
+	}
+	aclCachePtr.update([]acl.Group{{
+		GroupID: "eng",
+		Rules: []acl.Acl{
+			{Predicate: "name", Perm: acl.Read.Code},
+			{Predicate: "salary", Perm: acl.Read.Code, Filter: `eq(department, "eng")`},
+		},
+	}})
+
+	parsed, err := gql.Parse(gql.Request{Str: `{
+		me(func: has(name)) @filter(has(salary)) {
+			name
+			salary
+		}
+		names(func: has(name)) {
+			name
+		}
+	}`})
+	require.NoError(t, err)
+	preds := parsePredsFromQuery(parsed.Query)
+	require.NoError(t, addRowFilters(parsed.Query, []string{"eng"}, preds))
+
+	filter := parsed.Query[0].Filter
+	require.Equal(t, "and", filter.Op)
+	require.Len(t, filter.Child, 2)
+	require.Equal(t, "has", filter.Child[0].Func.Name)
+	require.Equal(t, "eq", filter.Child[1].Func.Name)
+	require.Equal(t, "department", filter.Child[1].Func.Attr)
+	require.Nil(t, parsed.Query[1].Filter, "blocks that don't read salary should be unchanged")
+}
+
+func TestAddRowFiltersWherePredicateIsUsed(t *testing.T) {
+	aclCachePtr = &aclCache{
+		predPerms: make(map[string]map[string]int32),
+	}
+	aclCachePtr.update([]acl.Group{{
+		GroupID: "eng",
+		Rules: []acl.Acl{
+			{Predicate: "name", Perm: acl.Read.Code},
+			{Predicate: "friend", Perm: acl.Read.Code},
+			{Predicate: "salary", Perm: acl.Read.Code, Filter: `eq(department, "eng")`},
+		},
+	}})
+
+	// rowFiltered returns whether the row filter of salary is part of filter.
+	var rowFiltered func(filter *gql.FilterTree) bool
+	rowFiltered = func(filter *gql.FilterTree) bool {
+		if filter == nil {
+			return false
+		}
+		if filter.Func != nil && filter.Func.Attr == "department" {
+			return true
+		}
+		for _, child := range filter.Child {
+			if rowFiltered(child) {
+				return true
+			}
+		}
+		return false
+	}
+
+	tests := []struct {
+		name  string
+		query string
+		// block returns the block that salary is used in.
+		block func(gqs []*gql.GraphQuery) *gql.GraphQuery
+	}{
+		{
+			name:  "root function",
+			query: `{ me(func: ge(salary, 100)) { name } }`,
+			block: func(gqs []*gql.GraphQuery) *gql.GraphQuery { return gqs[0] },
+		},
+		{
+			name:  "filter",
+			query: `{ me(func: has(name)) @filter(ge(salary, 100)) { name } }`,
+			block: func(gqs []*gql.GraphQuery) *gql.GraphQuery { return gqs[0] },
+		},
+		{
+			name:  "orderasc",
+			query: `{ me(func: has(name), orderasc: salary) { name } }`,
+			block: func(gqs []*gql.GraphQuery) *gql.GraphQuery { return gqs[0] },
+		},
+		{
+			name:  "orderdesc",
+			query: `{ me(func: has(name), orderdesc: salary) { name } }`,
+			block: func(gqs []*gql.GraphQuery) *gql.GraphQuery { return gqs[0] },
+		},
+		{
+			name:  "groupby",
+			query: `{ me(func: has(name)) { friend @groupby(salary) { count(uid) } } }`,
+			block: func(gqs []*gql.GraphQuery) *gql.GraphQuery { return gqs[0].Children[0] },
+		},
+		{
+			name:  "child filter",
+			query: `{ me(func: has(name)) { friend @filter(ge(salary, 100)) { name } } }`,
+			block: func(gqs []*gql.GraphQuery) *gql.GraphQuery { return gqs[0].Children[0] },
+		},
+		{
+			name: "var block",
+			query: `{
+				var(func: has(name)) @filter(ge(salary, 100)) { rich as uid }
+				me(func: uid(rich)) { name }
+			}`,
+			block: func(gqs []*gql.GraphQuery) *gql.GraphQuery { return gqs[0] },
+		},
+	}
+	for _, tc := range tests {
+		parsed, err := gql.Parse(gql.Request{Str: tc.query})
+		require.NoError(t, err, tc.name)
+		preds := parsePredsFromQuery(parsed.Query)
+		require.NoError(t, addRowFilters(parsed.Query, []string{"eng"}, preds), tc.name)
+		require.True(t, rowFiltered(tc.block(parsed.Query).Filter), tc.name)
+	}
+
+	// The nodes of @recurse and shortest path blocks can't all be filtered, so those blocks
+	// can't use salary.
+	for _, query := range []string{
+		`{ me(func: has(name)) @recurse { friend salary } }`,
+		`{
+			path as shortest(from: 0x1, to: 0x2) { friend @facets(weight) salary }
+			me(func: uid(path)) { name }
+		}`,
+	} {
+		parsed, err := gql.Parse(gql.Request{Str: query})
+		require.NoError(t, err, query)
+		preds := parsePredsFromQuery(parsed.Query)
+		err = addRowFilters(parsed.Query, []string{"eng"}, preds)
+		require.Error(t, err, query)
+		require.Equal(t, codes.PermissionDenied, status.Code(err), query)
+	}
+}
+
+func TestAclCacheContents(t *testing.T) {
+	aclCachePtr = &aclCache{
+		predPerms: make(map[string]map[string]int32),
+	}
+	require.Equal(t, &AclCacheContents{Groups: []AclCacheGroup{}}, AclCache())
+
+	aclCachePtr.update([]acl.Group{
+		{
+			GroupID: "sre",
+			Rules: []acl.Acl{
+				{Predicate: "name", Perm: acl.Write.Code},
+				{Predicate: "name", Perm: acl.Modify.Code, Deny: true},
+			},
+		},
+		{
+			GroupID: "dev",
+			Rules: []acl.Acl{
+				{Predicate: "salary", Perm: acl.Read.Code, Filter: `eq(department, "eng")`},
+				{Predicate: "age", Perm: acl.Read.Code},
+			},
+		},
+	})
+	aclCachePtr.updateUserCount(3)
+
+	contents := AclCache()
+	require.NotZero(t, contents.LastRefresh)
+	require.Equal(t, 3, contents.UserCount)
+	require.Equal(t, []AclCacheGroup{
+		{
+			Name: "dev",
+			Rules: []AclCacheRule{
+				{Predicate: "age", Permission: acl.Read.Code},
+				{Predicate: "salary", Permission: acl.Read.Code, Filter: `eq(department, "eng")`},
+			},
+		},
+		{
+			Name: "sre",
+			Rules: []AclCacheRule{
+				{Predicate: "name", Permission: acl.Write.Code},
+				{Predicate: "name", Permission: acl.Modify.Code, Deny: true},
+			},
+		},
+	}, contents.Groups)
+}
+
+func TestEvaluateAccess(t *testing.T) {
+	aclCachePtr = &aclCache{
+		predPerms: make(map[string]map[string]int32),
+	}
+	aclCachePtr.update([]acl.Group{{
+		GroupID: "dev",
+		Rules: []acl.Acl{
+			{Predicate: "name", Perm: acl.Read.Code},
+			{Predicate: "nickname", Perm: acl.Write.Code},
+		},
+	}})
+	query := `{
+		me(func: has(name)) {
+			name
+			nickname
+		}
+	}`
+
+	// dev can read name but not nickname, so the query is only partially allowed.
+	eval, err := EvaluateAccess("dev", nil, query)
+	require.NoError(t, err)
+	require.Equal(t, &AccessEvaluation{
+		Allowed: []string{"name"},
+		Denied:  []string{"nickname"},
+	}, eval)
+
+	// A proposed rule set is evaluated instead of the rules in the cache, which don't change.
+	eval, err = EvaluateAccess("dev", []AclCacheRule{
+		{Predicate: "name", Permission: acl.Read.Code},
+		{Predicate: "nickname", Permission: acl.Read.Code},
+	}, query)
+	require.NoError(t, err)
+	require.Equal(t, []string{"name", "nickname"}, eval.Allowed)
+	require.Empty(t, eval.Denied)
+	require.Error(t, aclCachePtr.authorizePredicate([]string{"dev"}, "nickname", acl.Read))
+
+	_, err = EvaluateAccess("dev", nil, "{ me(func: has(name)) {")
+	require.Error(t, err)
+}
+
+func TestStaleAclCache(t *testing.T) {
+	oldConfig, oldSecrets := worker.Config, hmacSecretsPtr
+	defer func() {
+		worker.Config, hmacSecretsPtr = oldConfig, oldSecrets
+	}()
+	hmacSecretsPtr = &hmacSecrets{}
+	worker.Config.HmacSecret = []byte("0123456789abcdef0123456789abcdef")
+	worker.Config.AccessJwtTtl = time.Minute
+	worker.Config.AclMaxStaleness = time.Minute
+
+	aclCachePtr = &aclCache{
+		predPerms: make(map[string]map[string]int32),
+	}
+	aclCachePtr.update([]acl.Group{{
+		GroupID: "dev",
+		Rules:   []acl.Acl{{Predicate: "name", Perm: acl.Read.Code | acl.Write.Code}},
+	}})
+
+	loggedIn := func(userId, group string) context.Context {
+		jwt, err := getAccessJwt(userId, []acl.Group{{GroupID: group}})
+		require.NoError(t, err)
+		return metadata.NewIncomingContext(context.Background(),
+			metadata.Pairs("accessJwt", jwt))
+	}
+	alice, groot := loggedIn("alice", "dev"), loggedIn(x.GrootId, x.GuardiansId)
+	query := func(ctx context.Context) error {
+		parsed, err := gql.Parse(gql.Request{Str: `{ me(func: has(name)) { name } }`})
+		require.NoError(t, err)
+		return authorizeQuery(ctx, &parsed, false)
+	}
+	mutation := &gql.Mutation{Set: []*api.NQuad{{
+		Subject:     "_:a",
+		Predicate:   "name",
+		ObjectValue: &api.Value{Val: &api.Value_StrVal{StrVal: "Alice"}},
+	}}}
+
+	require.NoError(t, query(alice))
+	require.NoError(t, authorizeMutation(alice, mutation))
+
+	aclCachePtr.Lock()
+	aclCachePtr.lastRefresh = time.Now().Add(-2 * time.Minute)
+	aclCachePtr.Unlock()
+
+	err := query(alice)
+	require.Error(t, err)
+	require.Equal(t, codes.Unavailable, status.Code(err))
+	require.Contains(t, err.Error(), "ACL cache stale")
+	err = authorizeMutation(alice, mutation)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "ACL cache stale")
+
+	// Guardians can still query, but not mutate.
+	require.NoError(t, query(groot))
+	err = authorizeMutation(groot, mutation)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "ACL cache stale")
+
+	// Nothing is denied without a maximum staleness.
+	worker.Config.AclMaxStaleness = 0
+	require.NoError(t, query(alice))
+	require.NoError(t, authorizeMutation(alice, mutation))
+}
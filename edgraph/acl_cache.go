@@ -13,23 +13,74 @@
 package edgraph
 
 import (
+	"fmt"
+	"sort"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/dgraph-io/dgraph/ee/acl"
 	"github.com/dgraph-io/dgraph/x"
+	"github.com/golang/glog"
 	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // aclCache is the cache mapping group names to the corresponding group acls
 type aclCache struct {
 	sync.RWMutex
 	predPerms map[string]map[string]int32
+	// predDenies has the same structure as predPerms, but holds the permissions denied by the
+	// deny rules.
+	predDenies map[string]map[string]int32
+	// predFilters maps a predicate to the groups whose rules for it grant read access only to
+	// the nodes matching a filter, and maps those groups to the filter.
+	predFilters map[string]map[string]string
+	// groupDefaults maps the groups that have a default permission to it. A group has its default
+	// permission on the predicates it has no rule for, except the reserved predicates.
+	groupDefaults map[string]int32
+	// lastRefresh is when the cache was last updated. groupCount and userCount are the number
+	// of groups and users there were at that time.
+	lastRefresh time.Time
+	groupCount  int
+	userCount   int
 }
 
 var aclCachePtr = &aclCache{
 	predPerms: make(map[string]map[string]int32),
 }
 
+// userRulesKey is the key that the rules set directly on the user with the given id are cached
+// under, in place of a group name.
+func userRulesKey(userId string) string {
+	return acl.UserRulesPrefix + userId
+}
+
+func isUserRulesKey(key string) bool {
+	return strings.HasPrefix(key, acl.UserRulesPrefix)
+}
+
+// ruleSets returns the sets of rules the cache is updated with: those of the groups, and those
+// set directly on the users, under the keys given by userRulesKey. Groups whose names look like
+// such a key can't be created, but the rules of any created before that are left out, so that
+// they aren't given to the user.
+func ruleSets(groups, users []acl.Group) []acl.Group {
+	sets := make([]acl.Group, 0, len(groups)+len(users))
+	for _, group := range groups {
+		if err := acl.ValidateGroupName(group.GroupID); err != nil {
+			glog.Warningf("Ignoring the rules of group %s: %v", group.GroupID, err)
+			continue
+		}
+		sets = append(sets, group)
+	}
+	for _, user := range users {
+		user.GroupID = userRulesKey(user.GroupID)
+		sets = append(sets, user)
+	}
+	return sets
+}
+
 func (cache *aclCache) update(groups []acl.Group) {
 	// In dgraph, acl rules are divided by groups, e.g.
 	// the dev group has the following blob representing its ACL rules
@@ -48,25 +99,129 @@ func (cache *aclCache) update(groups []acl.Group) {
 	// predPerms is the map descriebed above that maps a single
 	// predicate to a submap, and the submap maps a group to a permission
 	predPerms := make(map[string]map[string]int32)
+	// predDenies is built the same way from the deny rules.
+	predDenies := make(map[string]map[string]int32)
+	predFilters := make(map[string]map[string]string)
+	groupDefaults := make(map[string]int32)
+	readCode := acl.Read.Code
+	groupCount := 0
 	for _, group := range groups {
+		if !isUserRulesKey(group.GroupID) {
+			groupCount++
+			if group.DefaultPerm != 0 {
+				groupDefaults[group.GroupID] = group.DefaultPerm
+			}
+		}
 		acls := group.Rules
 
 		for _, acl := range acls {
 			if len(acl.Predicate) > 0 {
-				if groupPerms, found := predPerms[acl.Predicate]; found {
+				perms := predPerms
+				if acl.Deny {
+					perms = predDenies
+				}
+				if groupPerms, found := perms[acl.Predicate]; found {
 					groupPerms[group.GroupID] = acl.Perm
 				} else {
 					groupPerms := make(map[string]int32)
 					groupPerms[group.GroupID] = acl.Perm
-					predPerms[acl.Predicate] = groupPerms
+					perms[acl.Predicate] = groupPerms
+				}
+				if !acl.Deny && acl.Filter != "" && acl.Perm&readCode != 0 {
+					if _, found := predFilters[acl.Predicate]; !found {
+						predFilters[acl.Predicate] = make(map[string]string)
+					}
+					predFilters[acl.Predicate][group.GroupID] = acl.Filter
 				}
 			}
 		}
 	}
 
-	aclCachePtr.Lock()
-	defer aclCachePtr.Unlock()
-	aclCachePtr.predPerms = predPerms
+	cache.Lock()
+	defer cache.Unlock()
+	cache.predPerms = predPerms
+	cache.predDenies = predDenies
+	cache.predFilters = predFilters
+	cache.groupDefaults = groupDefaults
+	cache.groupCount = groupCount
+	cache.lastRefresh = time.Now()
+}
+
+// checkStale returns an error if the cache hasn't been refreshed for more than maxStaleness as of
+// now. If it has never been refreshed, it's as old as the alpha. The cache is never too stale if
+// maxStaleness isn't positive.
+func (cache *aclCache) checkStale(now time.Time, maxStaleness time.Duration) error {
+	if maxStaleness <= 0 {
+		return nil
+	}
+
+	cache.RLock()
+	lastRefresh := cache.lastRefresh
+	cache.RUnlock()
+	if lastRefresh.IsZero() {
+		lastRefresh = x.WorkerConfig.StartTime
+	}
+	if staleness := now.Sub(lastRefresh); staleness > maxStaleness {
+		return status.Errorf(codes.Unavailable, "ACL cache stale: it was last refreshed %v ago, "+
+			"more than the allowed %v", staleness.Round(time.Second), maxStaleness)
+	}
+	return nil
+}
+
+func (cache *aclCache) updateUserCount(userCount int) {
+	cache.Lock()
+	defer cache.Unlock()
+	cache.userCount = userCount
+}
+
+// contents rebuilds the rules of every group from the per-predicate maps of the cache.
+func (cache *aclCache) contents() *AclCacheContents {
+	cache.RLock()
+	defer cache.RUnlock()
+
+	rules := make(map[string][]AclCacheRule)
+	for pred, groupPerms := range cache.predPerms {
+		for group, perm := range groupPerms {
+			rules[group] = append(rules[group], AclCacheRule{
+				Predicate:  pred,
+				Permission: perm,
+				Filter:     cache.predFilters[pred][group],
+			})
+		}
+	}
+	for pred, groupPerms := range cache.predDenies {
+		for group, perm := range groupPerms {
+			rules[group] = append(rules[group],
+				AclCacheRule{Predicate: pred, Permission: perm, Deny: true})
+		}
+	}
+
+	contents := &AclCacheContents{UserCount: cache.userCount, Groups: []AclCacheGroup{}}
+	if !cache.lastRefresh.IsZero() {
+		contents.LastRefresh = cache.lastRefresh.Unix()
+	}
+	for group := range cache.groupDefaults {
+		if _, found := rules[group]; !found {
+			rules[group] = []AclCacheRule{}
+		}
+	}
+	for group, groupRules := range rules {
+		sort.Slice(groupRules, func(i, j int) bool {
+			if groupRules[i].Predicate != groupRules[j].Predicate {
+				return groupRules[i].Predicate < groupRules[j].Predicate
+			}
+			return !groupRules[i].Deny && groupRules[j].Deny
+		})
+		contents.Groups = append(contents.Groups, AclCacheGroup{
+			Name:              group,
+			Rules:             groupRules,
+			DefaultPermission: cache.groupDefaults[group],
+		})
+	}
+	sort.Slice(contents.Groups, func(i, j int) bool {
+		return contents.Groups[i].Name < contents.Groups[j].Name
+	})
+	return contents
 }
 
 func (cache *aclCache) authorizePredicate(groups []string, predicate string,
@@ -75,15 +230,28 @@ func (cache *aclCache) authorizePredicate(groups []string, predicate string,
 		return errors.Errorf("only groot is allowed to access the ACL predicate: %s", predicate)
 	}
 
-	aclCachePtr.RLock()
-	predPerms := aclCachePtr.predPerms
-	aclCachePtr.RUnlock()
+	cache.RLock()
+	predPerms := cache.predPerms
+	predDenies := cache.predDenies
+	groupDefaults := cache.groupDefaults
+	cache.RUnlock()
+
+	// A deny rule in any of the groups overrides the rules that grant the operation, even
+	// if they belong to other groups.
+	if groupDenies, found := predDenies[predicate]; found {
+		if hasRequiredAccess(groupDenies, groups, operation) {
+			return errors.Errorf("denied to do %s on predicate %s", operation.Name, predicate)
+		}
+	}
 
 	if groupPerms, found := predPerms[predicate]; found {
 		if hasRequiredAccess(groupPerms, groups, operation) {
 			return nil
 		}
 	}
+	if hasDefaultAccess(groupDefaults, predPerms[predicate], groups, predicate, operation) {
+		return nil
+	}
 
 	// no rule has been defined that can match the predicate
 	// by default we block operation
@@ -92,8 +260,38 @@ func (cache *aclCache) authorizePredicate(groups []string, predicate string,
 
 }
 
+// readFilters returns the filters that restrict the nodes whose values of predicate can be read
+// by a member of groups, who is known to have read access to it. The nodes matching any of the
+// filters can be read. If one of the groups grants read access without a filter, nil is returned.
+// The default permission of a group never has a filter.
+func (cache *aclCache) readFilters(groups []string, predicate string) []string {
+	cache.RLock()
+	groupPerms := cache.predPerms[predicate]
+	groupFilters := cache.predFilters[predicate]
+	groupDefaults := cache.groupDefaults
+	cache.RUnlock()
+
+	var filters []string
+	for _, group := range groups {
+		perm, found := groupPerms[group]
+		if !found && !x.IsReservedPredicate(predicate) {
+			perm = groupDefaults[group]
+		}
+		if perm&acl.Read.Code == 0 {
+			continue
+		}
+		filter, found := groupFilters[group]
+		if !found {
+			return nil
+		}
+		filters = append(filters, filter)
+	}
+	return filters
+}
+
 // hasRequiredAccess checks if any group in the passed in groups is allowed to perform the operation
-// according to the acl rules stored in groupPerms
+// according to the acl rules stored in groupPerms. When groupPerms holds deny rules, it checks if
+// the operation is denied instead.
 func hasRequiredAccess(groupPerms map[string]int32, groups []string,
 	operation *acl.Operation) bool {
 	for _, group := range groups {
@@ -104,3 +302,70 @@ func hasRequiredAccess(groupPerms map[string]int32, groups []string,
 	}
 	return false
 }
+
+// hasDefaultAccess checks if any group in the passed in groups that has no rule in groupPerms for
+// predicate is allowed to perform the operation by its default permission in groupDefaults.
+// Default permissions never apply to the reserved predicates.
+func hasDefaultAccess(groupDefaults, groupPerms map[string]int32, groups []string,
+	predicate string, operation *acl.Operation) bool {
+	if x.IsReservedPredicate(predicate) {
+		return false
+	}
+	for _, group := range groups {
+		if _, found := groupPerms[group]; found {
+			continue
+		}
+		if groupDefaults[group]&operation.Code != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// describeDenial explains why none of groups has access to do operation on predicate, by naming
+// the groups whose rules deny it or else the groups whose rules would grant it. It is logged by
+// permissionDenied, rather than returned to the user.
+func (cache *aclCache) describeDenial(groups []string, predicate string,
+	operation *acl.Operation) string {
+	if x.IsAclPredicate(predicate) {
+		return "only groot is allowed to access the ACL predicates"
+	}
+
+	cache.RLock()
+	groupPerms := cache.predPerms[predicate]
+	groupDenies := cache.predDenies[predicate]
+	groupDefaults := cache.groupDefaults
+	cache.RUnlock()
+
+	var denying []string
+	for _, group := range groups {
+		if groupDenies[group]&operation.Code != 0 {
+			denying = append(denying, group)
+		}
+	}
+	if len(denying) > 0 {
+		return fmt.Sprintf("%s is denied by the rules of the groups: %s", operation.Name,
+			strings.Join(denying, ", "))
+	}
+
+	var granting []string
+	for group, perm := range groupPerms {
+		// The rules of other users don't grant anything to this one.
+		if perm&operation.Code != 0 && !isUserRulesKey(group) {
+			granting = append(granting, group)
+		}
+	}
+	if !x.IsReservedPredicate(predicate) {
+		for group, perm := range groupDefaults {
+			if _, found := groupPerms[group]; !found && perm&operation.Code != 0 {
+				granting = append(granting, group)
+			}
+		}
+	}
+	if len(granting) == 0 {
+		return fmt.Sprintf("no group has %s access", operation.Name)
+	}
+	sort.Strings(granting)
+	return fmt.Sprintf("%s access requires membership in one of the groups: %s", operation.Name,
+		strings.Join(granting, ", "))
+}
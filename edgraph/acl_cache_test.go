@@ -55,3 +55,206 @@ func TestAclCache(t *testing.T) {
 	require.Error(t, aclCachePtr.authorizePredicate(emptyGroups, predicate, acl.Read),
 		"the anonymous user should not have access when the acl cache is empty")
 }
+
+func TestAclCacheDeny(t *testing.T) {
+	aclCachePtr = &aclCache{
+		predPerms:  make(map[string]map[string]int32),
+		predDenies: make(map[string]map[string]int32),
+	}
+
+	predicate := "friend"
+	groups := []acl.Group{
+		{
+			GroupID: "dev",
+			Rules: []acl.Acl{
+				{
+					Predicate: predicate,
+					Perm:      acl.Read.Code | acl.Write.Code,
+				},
+			},
+		},
+		{
+			GroupID: "quarantine",
+			Rules: []acl.Acl{
+				{
+					Predicate: predicate,
+					Perm:      acl.Read.Code,
+					Deny:      true,
+				},
+			},
+		},
+	}
+	aclCachePtr.update(groups)
+
+	require.NoError(t, aclCachePtr.authorizePredicate([]string{"dev"}, predicate, acl.Read),
+		"the dev group should have read access")
+	require.Error(t, aclCachePtr.authorizePredicate([]string{"dev", "quarantine"}, predicate,
+		acl.Read), "the deny rule of the quarantine group should override the read access")
+	require.NoError(t, aclCachePtr.authorizePredicate([]string{"dev", "quarantine"}, predicate,
+		acl.Write), "the deny rule should only deny the operations it has permissions for")
+	require.Error(t, aclCachePtr.authorizePredicate([]string{"quarantine"}, predicate, acl.Read),
+		"a deny rule should never grant access")
+}
+
+func TestAclCacheReadFilters(t *testing.T) {
+	aclCachePtr = &aclCache{
+		predPerms: make(map[string]map[string]int32),
+	}
+	aclCachePtr.update([]acl.Group{
+		{
+			GroupID: "eng",
+			Rules: []acl.Acl{{
+				Predicate: "salary",
+				Perm:      acl.Read.Code,
+				Filter:    `eq(department, "eng")`,
+			}},
+		},
+		{
+			GroupID: "sales",
+			Rules: []acl.Acl{{
+				Predicate: "salary",
+				Perm:      acl.Read.Code,
+				Filter:    `eq(department, "sales")`,
+			}},
+		},
+		{
+			GroupID: "hr",
+			Rules:   []acl.Acl{{Predicate: "salary", Perm: acl.Read.Code}},
+		},
+	})
+
+	require.Equal(t, []string{`eq(department, "eng")`},
+		aclCachePtr.readFilters([]string{"eng"}, "salary"))
+	require.Equal(t, []string{`eq(department, "eng")`, `eq(department, "sales")`},
+		aclCachePtr.readFilters([]string{"eng", "sales"}, "salary"))
+	require.Nil(t, aclCachePtr.readFilters([]string{"eng", "hr"}, "salary"),
+		"a rule without a filter should grant read access to every node")
+}
+
+func TestAclCacheUserRules(t *testing.T) {
+	aclCachePtr = &aclCache{
+		predPerms: make(map[string]map[string]int32),
+	}
+	aclCachePtr.update([]acl.Group{
+		{GroupID: "dev", Rules: []acl.Acl{{Predicate: "name", Perm: acl.Read.Code}}},
+		{GroupID: userRulesKey("alice"), Rules: []acl.Acl{{Predicate: "salary", Perm: 4}}},
+	})
+	require.Equal(t, 1, aclCachePtr.groupCount, "the rules of users aren't groups")
+
+	// The rules of a user are unioned with the rules of its groups.
+	require.Empty(t, authorizePreds("alice", nil, []string{"salary"}, acl.Read))
+	require.Empty(t, authorizePreds("alice", []string{"dev"}, []string{"name", "salary"},
+		acl.Read))
+	require.Contains(t, authorizePreds("alice", nil, []string{"salary"}, acl.Write), "salary")
+
+	// The rules of alice apply to no one else, even through a group named after them.
+	require.Contains(t, authorizePreds("bob", []string{"dev"}, []string{"salary"}, acl.Read),
+		"salary")
+	require.Contains(t, authorizePreds("bob", []string{userRulesKey("alice")},
+		[]string{"salary"}, acl.Read), "salary")
+	require.Equal(t, "Read access requires membership in one of the groups: dev",
+		aclCachePtr.describeDenial(ruleHolders("bob", nil), "name", acl.Read))
+	require.Equal(t, "no group has Read access",
+		aclCachePtr.describeDenial(ruleHolders("bob", nil), "salary", acl.Read))
+}
+
+func TestAclCacheGroupNamedLikeUserRules(t *testing.T) {
+	require.Error(t, acl.ValidateGroupName(userRulesKey("bob")))
+	require.NoError(t, acl.ValidateGroupName("dev"))
+
+	// A group named like the key of the rules of bob, created before such names were rejected,
+	// gives bob nothing and doesn't replace the rules set on bob.
+	aclCachePtr = &aclCache{
+		predPerms: make(map[string]map[string]int32),
+	}
+	aclCachePtr.update(ruleSets(
+		[]acl.Group{
+			{GroupID: "dev", Rules: []acl.Acl{{Predicate: "name", Perm: acl.Read.Code}}},
+			{GroupID: userRulesKey("bob"), Rules: []acl.Acl{
+				{Predicate: "salary", Perm: acl.Read.Code},
+				{Predicate: "age", Perm: acl.Read.Code, Deny: true},
+			}},
+		},
+		[]acl.Group{
+			{GroupID: "bob", Rules: []acl.Acl{{Predicate: "age", Perm: acl.Read.Code}}},
+		}))
+	require.Equal(t, 1, aclCachePtr.groupCount)
+	require.Contains(t, authorizePreds("bob", nil, []string{"salary"}, acl.Read), "salary")
+	require.Empty(t, authorizePreds("bob", nil, []string{"age"}, acl.Read))
+}
+
+func TestReadablePredicates(t *testing.T) {
+	aclCachePtr = &aclCache{
+		predPerms: make(map[string]map[string]int32),
+	}
+	// The rules that the ACL tests seed for the dev group of alice.
+	aclCachePtr.update([]acl.Group{
+		{GroupID: "dev", Rules: []acl.Acl{
+			{Predicate: "name", Perm: acl.Read.Code},
+			{Predicate: "nickname", Perm: acl.Write.Code},
+		}},
+		{GroupID: "sre", Rules: []acl.Acl{{Predicate: "age", Perm: acl.Read.Code}}},
+		{GroupID: userRulesKey("alice"), Rules: []acl.Acl{{Predicate: "salary", Perm: 4}}},
+	})
+
+	preds := ReadablePredicates("alice", []string{"dev"}, []string{"name", "nickname", "age"})
+	require.Equal(t, []string{"name", "salary"}, preds)
+	require.Contains(t, preds, "name")
+	require.NotContains(t, preds, "nickname")
+
+	// A deny rule in any group takes the predicate out of the readable set.
+	aclCachePtr.update([]acl.Group{
+		{GroupID: "dev", Rules: []acl.Acl{{Predicate: "name", Perm: acl.Read.Code}}},
+		{GroupID: "contractors", Rules: []acl.Acl{
+			{Predicate: "name", Perm: acl.Read.Code, Deny: true},
+		}},
+	})
+	require.Equal(t, []string{"name"}, ReadablePredicates("alice", []string{"dev"}, nil))
+	require.Empty(t, ReadablePredicates("alice", []string{"dev", "contractors"}, nil))
+}
+
+func TestAclCacheDefaultPermission(t *testing.T) {
+	aclCachePtr = &aclCache{
+		predPerms: make(map[string]map[string]int32),
+	}
+	aclCachePtr.update([]acl.Group{
+		{
+			GroupID:     "analysts",
+			DefaultPerm: acl.Read.Code,
+			Rules: []acl.Acl{
+				{Predicate: "salary", Perm: acl.Read.Code, Deny: true},
+				{Predicate: "notes", Perm: acl.Write.Code},
+			},
+		},
+		{GroupID: "dev", Rules: []acl.Acl{{Predicate: "name", Perm: acl.Read.Code}}},
+	})
+
+	groups := []string{"analysts"}
+	// A predicate with no rule is readable through the default permission of the group, but
+	// not writable.
+	require.NoError(t, aclCachePtr.authorizePredicate(groups, "age", acl.Read))
+	require.NoError(t, aclCachePtr.authorizePredicate(groups, "name", acl.Read))
+	require.Error(t, aclCachePtr.authorizePredicate(groups, "age", acl.Write))
+	require.Nil(t, aclCachePtr.readFilters(groups, "age"))
+
+	// Deny rules are the exceptions to the default permission, and a rule of the group on a
+	// predicate replaces its default permission on it.
+	require.Error(t, aclCachePtr.authorizePredicate(groups, "salary", acl.Read))
+	require.Error(t, aclCachePtr.authorizePredicate(groups, "notes", acl.Read))
+	require.NoError(t, aclCachePtr.authorizePredicate(groups, "notes", acl.Write))
+
+	// Reserved predicates are never defaulted.
+	require.Error(t, aclCachePtr.authorizePredicate(groups, "dgraph.type", acl.Read))
+	require.Error(t, aclCachePtr.authorizePredicate(groups, "dgraph.password", acl.Read))
+
+	// Other groups don't get the default permission.
+	require.Error(t, aclCachePtr.authorizePredicate([]string{"dev"}, "age", acl.Read))
+	require.Equal(t, "Read access requires membership in one of the groups: analysts",
+		aclCachePtr.describeDenial([]string{"dev"}, "age", acl.Read))
+
+	contents := aclCachePtr.contents()
+	require.Len(t, contents.Groups, 2)
+	require.Equal(t, "analysts", contents.Groups[0].Name)
+	require.Equal(t, acl.Read.Code, contents.Groups[0].DefaultPermission)
+	require.Zero(t, contents.Groups[1].DefaultPermission)
+}
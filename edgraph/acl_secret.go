@@ -0,0 +1,104 @@
+// +build !oss
+
+/*
+ * Copyright 2020 Dgraph Labs, Inc. All rights reserved.
+ *
+ * Licensed under the Dgraph Community License (the "License"); you
+ * may not use this file except in compliance with the License. You
+ * may obtain a copy of the License at
+ *
+ *     https://github.com/dgraph-io/dgraph/blob/master/licenses/DCL.txt
+ */
+
+package edgraph
+
+import (
+	"bytes"
+	"io/ioutil"
+	"sync"
+	"time"
+
+	"github.com/dgraph-io/dgraph/worker"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+)
+
+// hmacSecrets holds the secrets used to sign and verify the JWTs issued by this alpha. After the
+// secret is rotated, tokens signed with the previous secret are still accepted until
+// previousExpiry, so that clients holding an access JWT aren't logged out by the rotation.
+type hmacSecrets struct {
+	sync.RWMutex
+	// current is the secret new tokens are signed with. It's nil until the secret is rotated,
+	// in which case worker.Config.HmacSecret is used.
+	current        []byte
+	previous       []byte
+	previousExpiry time.Time
+}
+
+var hmacSecretsPtr = &hmacSecrets{}
+
+func (s *hmacSecrets) signingSecret() []byte {
+	s.RLock()
+	defer s.RUnlock()
+	if s.current == nil {
+		return worker.Config.HmacSecret
+	}
+	return s.current
+}
+
+// verificationSecrets returns the secrets a token can be signed with, the current one first.
+func (s *hmacSecrets) verificationSecrets() [][]byte {
+	s.RLock()
+	defer s.RUnlock()
+	secrets := [][]byte{worker.Config.HmacSecret}
+	if s.current != nil {
+		secrets[0] = s.current
+	}
+	if s.previous != nil && time.Now().Before(s.previousExpiry) {
+		secrets = append(secrets, s.previous)
+	}
+	return secrets
+}
+
+// rotate makes secret the signing secret, and keeps accepting tokens signed with the old one for
+// the grace period.
+func (s *hmacSecrets) rotate(secret []byte, grace time.Duration) {
+	s.Lock()
+	defer s.Unlock()
+	s.previous = worker.Config.HmacSecret
+	if s.current != nil {
+		s.previous = s.current
+	}
+	s.previousExpiry = time.Now().Add(grace)
+	s.current = secret
+}
+
+// RotateAclSecret re-reads the HMAC secret from worker.Config.AclSecretFile and starts signing
+// JWTs with it. Access JWTs signed with the previous secret remain valid for
+// worker.Config.AccessJwtTtl, which is as long as any of them could be valid anyway. Refresh
+// JWTs signed with the previous secret can't be used after that, so their users need to log in
+// again. The secret file must be updated and the secret rotated on every alpha of the cluster.
+// Callers are expected to have authorized the request as coming from a guardian.
+func RotateAclSecret() error {
+	if len(worker.Config.HmacSecret) == 0 {
+		return errors.New("ACL isn't enabled, so there's no secret to rotate")
+	}
+
+	secret, err := ioutil.ReadFile(worker.Config.AclSecretFile)
+	if err != nil {
+		return errors.Wrapf(err, "unable to read HMAC secret from file: %v",
+			worker.Config.AclSecretFile)
+	}
+	if len(secret) < 32 {
+		return errors.New("the HMAC secret file should contain at least 256 bits " +
+			"(32 ascii chars)")
+	}
+	if bytes.Equal(secret, hmacSecretsPtr.signingSecret()) {
+		return errors.Errorf("the HMAC secret in %v hasn't changed", worker.Config.AclSecretFile)
+	}
+
+	hmacSecretsPtr.rotate(secret, worker.Config.AccessJwtTtl)
+	glog.Infof("Rotated the HMAC secret. Tokens signed with the previous secret are accepted "+
+		"for another %v", worker.Config.AccessJwtTtl)
+	return nil
+}
@@ -0,0 +1,73 @@
+// +build !oss
+
+/*
+ * Copyright 2020 Dgraph Labs, Inc. All rights reserved.
+ *
+ * Licensed under the Dgraph Community License (the "License"); you
+ * may not use this file except in compliance with the License. You
+ * may obtain a copy of the License at
+ *
+ *     https://github.com/dgraph-io/dgraph/blob/master/licenses/DCL.txt
+ */
+
+package edgraph
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/dgraph-io/dgraph/ee/acl"
+	"github.com/dgraph-io/dgraph/worker"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRotateAclSecret(t *testing.T) {
+	secretFile, err := ioutil.TempFile("", "hmac")
+	require.NoError(t, err)
+	defer os.Remove(secretFile.Name())
+	require.NoError(t, secretFile.Close())
+
+	oldConfig, oldSecrets := worker.Config, hmacSecretsPtr
+	defer func() {
+		worker.Config, hmacSecretsPtr = oldConfig, oldSecrets
+	}()
+	hmacSecretsPtr = &hmacSecrets{}
+	worker.Config.HmacSecret = []byte("0123456789abcdef0123456789abcdef")
+	worker.Config.AclSecretFile = secretFile.Name()
+	worker.Config.AccessJwtTtl = time.Minute
+
+	oldJwt, err := getAccessJwt("alice", []acl.Group{{GroupID: "dev"}})
+	require.NoError(t, err)
+
+	// The secret file hasn't been updated yet.
+	require.NoError(t, ioutil.WriteFile(secretFile.Name(), worker.Config.HmacSecret, 0600))
+	require.Error(t, RotateAclSecret())
+	require.NoError(t, ioutil.WriteFile(secretFile.Name(), []byte("too short"), 0600))
+	require.Error(t, RotateAclSecret())
+
+	require.NoError(t, ioutil.WriteFile(secretFile.Name(),
+		[]byte("fedcba9876543210fedcba9876543210"), 0600))
+	require.NoError(t, RotateAclSecret())
+
+	newJwt, err := getAccessJwt("alice", []acl.Group{{GroupID: "dev"}})
+	require.NoError(t, err)
+	require.NotEqual(t, oldJwt, newJwt)
+
+	userData, err := validateToken(oldJwt)
+	require.NoError(t, err, "tokens signed with the old secret are valid during the grace window")
+	require.Equal(t, []string{"alice", "dev"}, userData)
+
+	// Tokens signed with the old secret are rejected once the grace window is over, even if they
+	// haven't expired themselves.
+	hmacSecretsPtr.Lock()
+	hmacSecretsPtr.previousExpiry = time.Now().Add(-time.Millisecond)
+	hmacSecretsPtr.Unlock()
+	_, err = validateToken(oldJwt)
+	require.Error(t, err)
+
+	userData, err = validateToken(newJwt)
+	require.NoError(t, err)
+	require.Equal(t, []string{"alice", "dev"}, userData)
+}
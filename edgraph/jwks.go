@@ -0,0 +1,210 @@
+// +build !oss
+
+/*
+ * Copyright 2020 Dgraph Labs, Inc. All rights reserved.
+ *
+ * Licensed under the Dgraph Community License (the "License"); you
+ * may not use this file except in compliance with the License. You
+ * may obtain a copy of the License at
+ *
+ *     https://github.com/dgraph-io/dgraph/blob/master/licenses/DCL.txt
+ */
+
+package edgraph
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/dgraph-io/dgo/v2/protos/api"
+	"github.com/dgraph-io/dgraph/ee/acl"
+	"github.com/dgraph-io/dgraph/worker"
+	"github.com/dgraph-io/dgraph/x"
+	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+)
+
+// jwksCache caches the RSA keys published at worker.Config.AclJwksUrl by an external identity
+// provider. The keys are used to verify the JWTs issued by that provider.
+type jwksCache struct {
+	sync.RWMutex
+	keys map[string]*rsa.PublicKey
+}
+
+var jwksCachePtr = &jwksCache{
+	keys: make(map[string]*rsa.PublicKey),
+}
+
+// jsonWebKey is a single key of a JSON Web Key Set, as defined in RFC 7517.
+type jsonWebKey struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+func (cache *jwksCache) refresh() error {
+	client := &http.Client{Timeout: 10 * time.Second}
+	resp, err := client.Get(worker.Config.AclJwksUrl)
+	if err != nil {
+		return errors.Wrapf(err, "while fetching JWKS from %s", worker.Config.AclJwksUrl)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("fetching JWKS from %s returned status %s",
+			worker.Config.AclJwksUrl, resp.Status)
+	}
+
+	var keySet struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&keySet); err != nil {
+		return errors.Wrapf(err, "while decoding JWKS from %s", worker.Config.AclJwksUrl)
+	}
+
+	keys := make(map[string]*rsa.PublicKey)
+	for _, key := range keySet.Keys {
+		if key.Kty != "RSA" {
+			continue
+		}
+		n, err := base64.RawURLEncoding.DecodeString(key.N)
+		if err != nil {
+			return errors.Wrapf(err, "invalid modulus of key %q", key.Kid)
+		}
+		e, err := base64.RawURLEncoding.DecodeString(key.E)
+		if err != nil {
+			return errors.Wrapf(err, "invalid exponent of key %q", key.Kid)
+		}
+		keys[key.Kid] = &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+	}
+
+	cache.Lock()
+	defer cache.Unlock()
+	cache.keys = keys
+	return nil
+}
+
+// key returns the key with the given key id. A token without a key id can only be verified if
+// the identity provider publishes a single key.
+func (cache *jwksCache) key(kid string) (*rsa.PublicKey, error) {
+	lookup := func() *rsa.PublicKey {
+		cache.RLock()
+		defer cache.RUnlock()
+		if kid == "" && len(cache.keys) == 1 {
+			for _, key := range cache.keys {
+				return key
+			}
+		}
+		return cache.keys[kid]
+	}
+
+	if key := lookup(); key != nil {
+		return key, nil
+	}
+	// The key may be missing because the identity provider has rotated its keys.
+	if err := cache.refresh(); err != nil {
+		return nil, err
+	}
+	if key := lookup(); key != nil {
+		return key, nil
+	}
+	return nil, errors.Errorf("no key with id %q found in JWKS", kid)
+}
+
+// validateExternalToken verifies the signature and expiration of a jwt issued by the external
+// identity provider. If validation passes, it returns a slice of strings, where the first element
+// is the user id from the sub claim and the rest are the groups from the claim set by
+// worker.Config.AclJwtGroupsClaim.
+func validateExternalToken(jwtStr string) ([]string, error) {
+	token, err := jwt.Parse(jwtStr, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodRSA); !ok {
+			return nil, errors.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		kid, _ := token.Header["kid"].(string)
+		return jwksCachePtr.key(kid)
+	})
+	if err != nil {
+		return nil, errors.Errorf("unable to parse external jwt token:%v", err)
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok || !token.Valid {
+		return nil, errors.Errorf("claims in external jwt token is not map claims")
+	}
+	if !claims.VerifyExpiresAt(time.Now().Unix(), true) {
+		return nil, errors.Errorf("Token is expired")
+	}
+
+	userId, ok := claims["sub"].(string)
+	if !ok || userId == "" {
+		return nil, errors.Errorf("sub in claims is not a string:%v", claims["sub"])
+	}
+
+	groups, _ := claims[worker.Config.AclJwtGroupsClaim].([]interface{})
+	groupIds := make([]string, 0, len(groups))
+	for _, group := range groups {
+		groupId, ok := group.(string)
+		if !ok {
+			return nil, errors.Errorf("unable to convert group to string:%v", group)
+		}
+		groupIds = append(groupIds, groupId)
+	}
+	return append([]string{userId}, groupIds...), nil
+}
+
+// LoginWithExternalJwt handles login requests that present a jwt issued by an external identity
+// provider instead of a user id and password. The groups in the token are used as the ACL groups
+// of the session, without a User node being created in Dgraph. Since there's no such node to
+// authenticate a refresh token against, only an access jwt is returned.
+func (s *Server) LoginWithExternalJwt(ctx context.Context,
+	externalJwt string) (*api.Response, error) {
+
+	if err := x.HealthCheck(); err != nil {
+		return nil, err
+	}
+
+	if !worker.EnterpriseEnabled() {
+		return nil, errors.New("Enterprise features are disabled. You can enable them by " +
+			"supplying the appropriate license file to Dgraph Zero using the HTTP endpoint.")
+	}
+
+	if len(worker.Config.AclJwksUrl) == 0 {
+		return nil, errors.New("login with an external jwt isn't enabled. Set --acl_jwks_url to " +
+			"enable it.")
+	}
+
+	userData, err := validateExternalToken(externalJwt)
+	if err != nil {
+		glog.Errorf("Authentication with external jwt failed: %v", err)
+		return nil, errors.Wrapf(err, "authentication with external jwt failed")
+	}
+	glog.Infof("%s logged in successfully with external jwt", userData[0])
+
+	groups := make([]acl.Group, 0, len(userData)-1)
+	for _, groupId := range userData[1:] {
+		groups = append(groups, acl.Group{GroupID: groupId})
+	}
+	accessJwt, err := getAccessJwt(userData[0], groups)
+	if err != nil {
+		return nil, errors.Errorf("unable to get access jwt (userid=%s):%v", userData[0], err)
+	}
+
+	loginJwt := api.Jwt{
+		AccessJwt: accessJwt,
+	}
+	jwtBytes, err := loginJwt.Marshal()
+	if err != nil {
+		return nil, errors.Errorf("unable to marshal jwt (userid=%s):%v", userData[0], err)
+	}
+	return &api.Response{Json: jwtBytes}, nil
+}
@@ -0,0 +1,98 @@
+// +build !oss
+
+/*
+ * Copyright 2020 Dgraph Labs, Inc. All rights reserved.
+ *
+ * Licensed under the Dgraph Community License (the "License"); you
+ * may not use this file except in compliance with the License. You
+ * may obtain a copy of the License at
+ *
+ *     https://github.com/dgraph-io/dgraph/blob/master/licenses/DCL.txt
+ */
+
+package edgraph
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/dgraph-io/dgraph/ee/acl"
+	"github.com/dgraph-io/dgraph/worker"
+	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExternalJwtGroupMapping(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	// A stub identity provider that publishes the public key as a JWKS.
+	jwksServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]interface{}{
+			"keys": []jsonWebKey{{
+				Kid: "test-key",
+				Kty: "RSA",
+				N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			}},
+		}))
+	}))
+	defer jwksServer.Close()
+
+	oldConfig := worker.Config
+	defer func() {
+		worker.Config = oldConfig
+	}()
+	worker.Config.AclJwksUrl = jwksServer.URL
+	worker.Config.AclJwtGroupsClaim = "roles"
+
+	sign := func(claims jwt.MapClaims, signingKey *rsa.PrivateKey) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+		token.Header["kid"] = "test-key"
+		signed, err := token.SignedString(signingKey)
+		require.NoError(t, err)
+		return signed
+	}
+
+	userData, err := validateExternalToken(sign(jwt.MapClaims{
+		"sub":   "alice@example.com",
+		"roles": []string{"dev"},
+		"exp":   time.Now().Add(time.Minute).Unix(),
+	}, key))
+	require.NoError(t, err)
+	require.Equal(t, []string{"alice@example.com", "dev"}, userData)
+
+	// The rules of the mapped group apply to the user.
+	aclCachePtr = &aclCache{
+		predPerms: make(map[string]map[string]int32),
+	}
+	aclCachePtr.update([]acl.Group{{
+		GroupID: "dev",
+		Rules:   []acl.Acl{{Predicate: "name", Perm: acl.Read.Code}},
+	}})
+	require.NoError(t, aclCachePtr.authorizePredicate(userData[1:], "name", acl.Read))
+	require.Error(t, aclCachePtr.authorizePredicate(userData[1:], "name", acl.Write))
+
+	_, err = validateExternalToken(sign(jwt.MapClaims{
+		"sub":   "alice@example.com",
+		"roles": []string{"dev"},
+		"exp":   time.Now().Add(-time.Minute).Unix(),
+	}, key))
+	require.Error(t, err, "expired tokens should be rejected")
+
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	_, err = validateExternalToken(sign(jwt.MapClaims{
+		"sub":   "mallory@example.com",
+		"roles": []string{"guardians"},
+		"exp":   time.Now().Add(time.Minute).Unix(),
+	}, otherKey))
+	require.Error(t, err, "tokens not signed by the identity provider should be rejected")
+}
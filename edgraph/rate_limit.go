@@ -0,0 +1,131 @@
+// +build !oss
+
+/*
+ * Copyright 2020 Dgraph Labs, Inc. All rights reserved.
+ *
+ * Licensed under the Dgraph Community License (the "License"); you
+ * may not use this file except in compliance with the License. You
+ * may obtain a copy of the License at
+ *
+ *     https://github.com/dgraph-io/dgraph/blob/master/licenses/DCL.txt
+ */
+
+package edgraph
+
+import (
+	"context"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/dgraph-io/dgraph/worker"
+	"github.com/dgraph-io/dgraph/x"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxTrackedKeys is the number of keys a rateLimiter tracks before it forgets the ones whose
+// allowance has been refilled.
+const maxTrackedKeys = 10000
+
+// allowance is the number of requests a key has left, as of the time it was last updated.
+type allowance struct {
+	tokens  float64
+	updated time.Time
+}
+
+// rateLimiter limits the rate of requests for each key, such as the IP a login comes from or
+// the user making a query. Every key can make burst requests in a row, after which its
+// allowance is refilled at rate requests per minute.
+type rateLimiter struct {
+	sync.Mutex
+	allowances map[string]*allowance
+}
+
+func newRateLimiter() *rateLimiter {
+	return &rateLimiter{allowances: make(map[string]*allowance)}
+}
+
+var (
+	// loginLimiterPtr limits password logins by IP, so that an IP trying many passwords,
+	// possibly across many users, is slowed down.
+	loginLimiterPtr = newRateLimiter()
+	// userRequestLimiterPtr limits queries and mutations by user, so that one user can't starve
+	// the others.
+	userRequestLimiterPtr = newRateLimiter()
+)
+
+// refill adds the requests earned since the allowance was last updated, up to burst.
+func (a *allowance) refill(now time.Time, rate, burst int) {
+	a.tokens += now.Sub(a.updated).Minutes() * float64(rate)
+	if a.tokens > float64(burst) {
+		a.tokens = float64(burst)
+	}
+	a.updated = now
+}
+
+// allow reports whether a request for key is allowed at now, and if so, takes it from the
+// allowance of key. All requests are allowed if rate isn't positive.
+func (l *rateLimiter) allow(key string, now time.Time, rate, burst int) bool {
+	if rate <= 0 {
+		return true
+	}
+	if burst < 1 {
+		burst = 1
+	}
+
+	l.Lock()
+	defer l.Unlock()
+
+	if len(l.allowances) >= maxTrackedKeys {
+		for k, a := range l.allowances {
+			if a.refill(now, rate, burst); a.tokens >= float64(burst) {
+				delete(l.allowances, k)
+			}
+		}
+	}
+
+	a, ok := l.allowances[key]
+	if !ok {
+		a = &allowance{tokens: float64(burst), updated: now}
+		l.allowances[key] = a
+	}
+	a.refill(now, rate, burst)
+	if a.tokens < 1 {
+		return false
+	}
+	a.tokens--
+	return true
+}
+
+// allowLogin reports whether a password login from addr, which is the address of the client
+// with or without a port, is allowed by --acl_login_rate and --acl_login_burst.
+func allowLogin(addr string) bool {
+	ip := addr
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		ip = host
+	}
+	return loginLimiterPtr.allow(ip, time.Now(), worker.Config.AclLoginRate,
+		worker.Config.AclLoginBurst)
+}
+
+// limitUserRequests returns a ResourceExhausted error if the user making the request has used
+// up the requests allowed by --acl_user_request_rate and --acl_user_request_burst. Guardians
+// aren't limited, and requests without a valid access JWT are left to be denied when they're
+// authorized.
+func limitUserRequests(ctx context.Context) error {
+	if len(worker.Config.HmacSecret) == 0 || worker.Config.AclUserRequestRate <= 0 {
+		return nil
+	}
+	userData, err := extractUserAndGroups(ctx)
+	if err != nil || x.IsGuardian(userData[1:]) {
+		return nil
+	}
+	// The rate is per second, while rateLimiter refills per minute.
+	if !userRequestLimiterPtr.allow(userData[0], time.Now(),
+		60*worker.Config.AclUserRequestRate, worker.Config.AclUserRequestBurst) {
+		return status.Errorf(codes.ResourceExhausted,
+			"too many requests from user %s, try again later", userData[0])
+	}
+	return nil
+}
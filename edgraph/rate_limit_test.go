@@ -0,0 +1,87 @@
+// +build !oss
+
+/*
+ * Copyright 2020 Dgraph Labs, Inc. All rights reserved.
+ *
+ * Licensed under the Dgraph Community License (the "License"); you
+ * may not use this file except in compliance with the License. You
+ * may obtain a copy of the License at
+ *
+ *     https://github.com/dgraph-io/dgraph/blob/master/licenses/DCL.txt
+ */
+
+package edgraph
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dgraph-io/dgraph/ee/acl"
+	"github.com/dgraph-io/dgraph/worker"
+	"github.com/dgraph-io/dgraph/x"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestLoginLimiter(t *testing.T) {
+	limiter := newRateLimiter()
+	now := time.Now()
+	const rate, burst = 6, 3
+
+	for i := 0; i < burst; i++ {
+		require.True(t, limiter.allow("10.0.0.1", now, rate, burst))
+	}
+	require.False(t, limiter.allow("10.0.0.1", now, rate, burst),
+		"logins after the burst should be throttled")
+	require.True(t, limiter.allow("10.0.0.2", now, rate, burst),
+		"other IPs should be unaffected")
+
+	// A login is earned back every 10s at 6 logins per minute.
+	require.False(t, limiter.allow("10.0.0.1", now.Add(5*time.Second), rate, burst))
+	require.True(t, limiter.allow("10.0.0.1", now.Add(10*time.Second), rate, burst))
+	require.False(t, limiter.allow("10.0.0.1", now.Add(10*time.Second), rate, burst))
+
+	// Logins aren't limited without a rate.
+	for i := 0; i < 2*burst; i++ {
+		require.True(t, limiter.allow("10.0.0.3", now, 0, burst))
+	}
+}
+
+func TestLimitUserRequests(t *testing.T) {
+	oldConfig, oldSecrets, oldLimiter := worker.Config, hmacSecretsPtr, userRequestLimiterPtr
+	defer func() {
+		worker.Config, hmacSecretsPtr, userRequestLimiterPtr = oldConfig, oldSecrets, oldLimiter
+	}()
+	hmacSecretsPtr = &hmacSecrets{}
+	userRequestLimiterPtr = newRateLimiter()
+	worker.Config.HmacSecret = []byte("0123456789abcdef0123456789abcdef")
+	worker.Config.AccessJwtTtl = time.Minute
+	worker.Config.AclUserRequestRate = 1
+	worker.Config.AclUserRequestBurst = 2
+
+	loggedIn := func(userId, group string) context.Context {
+		jwt, err := getAccessJwt(userId, []acl.Group{{GroupID: group}})
+		require.NoError(t, err)
+		return metadata.NewIncomingContext(context.Background(),
+			metadata.Pairs("accessJwt", jwt))
+	}
+	alice, bob := loggedIn("alice", "dev"), loggedIn("bob", "dev")
+	groot := loggedIn(x.GrootId, x.GuardiansId)
+
+	for i := 0; i < 2; i++ {
+		require.NoError(t, limitUserRequests(alice))
+	}
+	err := limitUserRequests(alice)
+	require.Error(t, err)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+	require.Contains(t, err.Error(), "too many requests from user alice")
+
+	// Other users have their own allowance, and guardians aren't limited.
+	require.NoError(t, limitUserRequests(bob))
+	for i := 0; i < 5; i++ {
+		require.NoError(t, limitUserRequests(groot))
+	}
+}
@@ -141,6 +141,9 @@ func (s *Server) Alter(ctx context.Context, op *api.Operation) (*api.Payload, er
 	if err := x.HealthCheck(); err != nil {
 		return empty, err
 	}
+	if err := x.DrainingCheck(); err != nil {
+		return empty, err
+	}
 
 	if isDropAll(op) && op.DropOp == api.Operation_DATA {
 		return nil, errors.Errorf("Only one of DropAll and DropData can be true")
@@ -670,10 +673,14 @@ func (s *Server) Health(ctx context.Context, all bool) (*api.Response, error) {
 		}
 	}
 	// Append self.
+	status := "healthy"
+	if x.IsDraining() {
+		status = "draining"
+	}
 	healthAll = append(healthAll, pb.HealthInfo{
 		Instance: "alpha",
 		Address:  x.WorkerConfig.MyAddr,
-		Status:   "healthy",
+		Status:   status,
 		Group:    strconv.Itoa(int(worker.GroupId())),
 		Version:  x.Version(),
 		Uptime:   int64(time.Since(x.WorkerConfig.StartTime) / time.Second),
@@ -688,6 +695,50 @@ func (s *Server) Health(ctx context.Context, all bool) (*api.Response, error) {
 	return &api.Response{Json: jsonOut}, nil
 }
 
+// AclStatus is the state of the ACL subsystem of an alpha.
+type AclStatus struct {
+	Enabled bool `json:"enabled"`
+	// LastCacheRefresh is the unix time of the last refresh of the ACL cache, or 0 if it
+	// hasn't been refreshed yet.
+	LastCacheRefresh int64 `json:"lastCacheRefresh"`
+	UserCount        int   `json:"userCount"`
+	GroupCount       int   `json:"groupCount"`
+}
+
+// AclCacheContents is what the ACL cache of an alpha holds.
+type AclCacheContents struct {
+	// LastRefresh is the unix time of the last refresh of the ACL cache, or 0 if it hasn't been
+	// refreshed yet.
+	LastRefresh int64 `json:"lastRefresh"`
+	UserCount   int   `json:"userCount"`
+	// Groups are the groups that have rules or a default permission, sorted by name.
+	Groups []AclCacheGroup `json:"groups"`
+}
+
+// AclCacheGroup is a group in the ACL cache, with its rules sorted by predicate.
+type AclCacheGroup struct {
+	Name  string         `json:"name"`
+	Rules []AclCacheRule `json:"rules"`
+	// DefaultPermission is the permission the group has on the predicates it has no rule for.
+	DefaultPermission int32 `json:"defaultPermission"`
+}
+
+// AclCacheRule is a rule in the ACL cache.
+type AclCacheRule struct {
+	Predicate  string `json:"predicate"`
+	Permission int32  `json:"permission"`
+	Deny       bool   `json:"deny"`
+	Filter     string `json:"filter"`
+}
+
+// AccessEvaluation is the result of evaluating whether the members of a group can read the
+// predicates of a query.
+type AccessEvaluation struct {
+	// Allowed and Denied are the predicates of the query that can and can't be read, sorted.
+	Allowed []string `json:"allowed"`
+	Denied  []string `json:"denied"`
+}
+
 // State handles state requests
 func (s *Server) State(ctx context.Context) (*api.Response, error) {
 	if ctx.Err() != nil {
@@ -761,6 +812,11 @@ func (s *Server) doQuery(ctx context.Context, req *api.Request, doAuth AuthMode)
 	if rerr = x.HealthCheck(); rerr != nil {
 		return
 	}
+	if isMutation {
+		if rerr = x.DrainingCheck(); rerr != nil {
+			return
+		}
+	}
 
 	req.Query = strings.TrimSpace(req.Query)
 	isQuery := len(req.Query) != 0
@@ -993,6 +1049,9 @@ func parseRequest(qc *queryContext) error {
 }
 
 func authorizeRequest(ctx context.Context, qc *queryContext) error {
+	if err := limitUserRequests(ctx); err != nil {
+		return err
+	}
 	if err := authorizeQuery(ctx, &qc.gqlRes, qc.graphql); err != nil {
 		return err
 	}
@@ -0,0 +1,157 @@
+// +build !oss
+
+/*
+ * Copyright 2020 Dgraph Labs, Inc. All rights reserved.
+ *
+ * Licensed under the Dgraph Community License (the "License"); you
+ * may not use this file except in compliance with the License. You
+ * may obtain a copy of the License at
+ *
+ *     https://github.com/dgraph-io/dgraph/blob/master/licenses/DCL.txt
+ */
+
+package edgraph
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/dgraph-io/dgo/v2/protos/api"
+	"github.com/dgraph-io/dgraph/ee/acl"
+	"github.com/dgraph-io/dgraph/worker"
+	"github.com/dgraph-io/dgraph/x"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/metadata"
+)
+
+// The TOTP codes are generated as described in RFC 6238, with the defaults that authenticator
+// apps expect: HMAC-SHA1, 6 digits and a period of 30 seconds.
+const (
+	totpPeriod = 30
+	totpDigits = 6
+	// totpSkew is the number of periods before and after the current one whose codes are also
+	// accepted, to allow for clock skew between the alpha and the authenticator.
+	totpSkew = 1
+)
+
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+func newTotpSecret() (string, error) {
+	secret := make([]byte, 20)
+	if _, err := rand.Read(secret); err != nil {
+		return "", errors.Wrapf(err, "unable to generate a TOTP secret")
+	}
+	return totpEncoding.EncodeToString(secret), nil
+}
+
+func totpCode(key []byte, counter uint64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], counter)
+	mac := hmac.New(sha1.New, key)
+	x.Check2(mac.Write(msg[:]))
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0xf
+	code := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%0*d", totpDigits, code%1000000)
+}
+
+// validateTotp returns whether code is the code for secret at the time now, or in one of the
+// totpSkew periods around it.
+func validateTotp(secret, code string, now time.Time) bool {
+	key, err := totpEncoding.DecodeString(strings.ToUpper(secret))
+	if err != nil {
+		glog.Errorf("Invalid TOTP secret: %v", err)
+		return false
+	}
+	counter := uint64(now.Unix()) / totpPeriod
+	for skew := -totpSkew; skew <= totpSkew; skew++ {
+		expected := totpCode(key, counter+uint64(skew))
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+			return true
+		}
+	}
+	return false
+}
+
+// totpCodeFromContext returns the TOTP code sent with a login request, in the totp-code key of
+// the request metadata.
+func totpCodeFromContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if codes := md.Get("totp-code"); len(codes) > 0 {
+		return codes[0]
+	}
+	return ""
+}
+
+// checkSecondFactor checks the TOTP code sent with a login request if user has MFA enabled.
+// Users without MFA don't need to send a code.
+func checkSecondFactor(ctx context.Context, user *acl.User) error {
+	if user.TotpSecret == "" {
+		return nil
+	}
+	code := totpCodeFromContext(ctx)
+	if code == "" {
+		return errors.Errorf("user %v has MFA enabled, so a TOTP code is required", user.UserID)
+	}
+	if !validateTotp(user.TotpSecret, code, time.Now()) {
+		return errors.Errorf("invalid TOTP code for user %v", user.UserID)
+	}
+	return nil
+}
+
+// EnableTotp enables MFA for the user with the given id, by giving it a new TOTP secret. It
+// returns the otpauth URI of the secret, to be added to an authenticator app. Callers are
+// expected to have authorized the request as coming from a guardian.
+func EnableTotp(ctx context.Context, userId string) (string, error) {
+	if len(worker.Config.HmacSecret) == 0 {
+		return "", errors.New("ACL isn't enabled, so MFA can't be enabled")
+	}
+
+	userId, err := ResolveUserId(ctx, userId)
+	if err != nil {
+		return "", err
+	}
+	user, err := authorizeUser(ctx, userId, "")
+	if err != nil {
+		return "", errors.Wrapf(err, "while querying user with id %v", userId)
+	}
+	if user == nil {
+		return "", errors.Errorf("user %v doesn't exist", userId)
+	}
+
+	secret, err := newTotpSecret()
+	if err != nil {
+		return "", err
+	}
+	req := &api.Request{
+		CommitNow: true,
+		Mutations: []*api.Mutation{{
+			Set: []*api.NQuad{{
+				Subject:     user.Uid,
+				Predicate:   "dgraph.user.totp",
+				ObjectValue: &api.Value{Val: &api.Value_StrVal{StrVal: secret}},
+			}},
+		}},
+	}
+	if _, err := (&Server{}).doQuery(ctx, req, NoAuthorize); err != nil {
+		return "", err
+	}
+	glog.Infof("Enabled MFA for %s", userId)
+
+	return fmt.Sprintf("otpauth://totp/%s?secret=%s&issuer=Dgraph&digits=%d&period=%d",
+		url.PathEscape("Dgraph:"+userId), secret, totpDigits, totpPeriod), nil
+}
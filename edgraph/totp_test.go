@@ -0,0 +1,65 @@
+// +build !oss
+
+/*
+ * Copyright 2020 Dgraph Labs, Inc. All rights reserved.
+ *
+ * Licensed under the Dgraph Community License (the "License"); you
+ * may not use this file except in compliance with the License. You
+ * may obtain a copy of the License at
+ *
+ *     https://github.com/dgraph-io/dgraph/blob/master/licenses/DCL.txt
+ */
+
+package edgraph
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dgraph-io/dgraph/ee/acl"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestTotpCode(t *testing.T) {
+	// The SHA1 test vector from RFC 6238, truncated to 6 digits.
+	require.Equal(t, "287082", totpCode([]byte("12345678901234567890"), 1))
+}
+
+func TestValidateTotp(t *testing.T) {
+	secret, err := newTotpSecret()
+	require.NoError(t, err)
+	key, err := totpEncoding.DecodeString(secret)
+	require.NoError(t, err)
+
+	now := time.Now()
+	counter := uint64(now.Unix()) / totpPeriod
+	require.True(t, validateTotp(secret, totpCode(key, counter), now))
+	require.True(t, validateTotp(secret, totpCode(key, counter-1), now),
+		"codes from the previous period should be accepted to allow for clock skew")
+	require.False(t, validateTotp(secret, totpCode(key, counter-3), now),
+		"expired codes should be rejected")
+	require.False(t, validateTotp(secret, "", now))
+}
+
+func TestCheckSecondFactor(t *testing.T) {
+	require.NoError(t, checkSecondFactor(context.Background(), &acl.User{UserID: "alice"}),
+		"users without MFA shouldn't need a code")
+
+	secret, err := newTotpSecret()
+	require.NoError(t, err)
+	key, err := totpEncoding.DecodeString(secret)
+	require.NoError(t, err)
+	user := &acl.User{UserID: "alice", TotpSecret: secret}
+
+	require.EqualError(t, checkSecondFactor(context.Background(), user),
+		"user alice has MFA enabled, so a TOTP code is required")
+
+	code := totpCode(key, uint64(time.Now().Unix())/totpPeriod)
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("totp-code", code))
+	require.NoError(t, checkSecondFactor(ctx, user))
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs("totp-code", "abcdef"))
+	require.EqualError(t, checkSecondFactor(ctx, user), "invalid TOTP code for user alice")
+}
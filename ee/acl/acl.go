@@ -128,6 +128,10 @@ func userAdd(conf *viper.Viper, userid string, password string) error {
 }
 
 func groupAdd(conf *viper.Viper, groupId string) error {
+	if err := ValidateGroupName(groupId); err != nil {
+		return err
+	}
+
 	dc, cancel, err := getClientWithAdminCtx(conf)
 	if err != nil {
 		return errors.Wrapf(err, "unable to get admin context")
@@ -522,6 +526,7 @@ func queryUser(ctx context.Context, txn *dgo.Txn, userid string) (user *User, er
       user(func: eq(dgraph.xid, $userid)) @filter(type(User)) {
 	    uid
         dgraph.xid
+        dgraph.user.disabled
         dgraph.user.group {
           uid
           dgraph.xid
@@ -601,6 +606,9 @@ func queryAndPrintUser(ctx context.Context, txn *dgo.Txn, userId string) error {
 
 	fmt.Printf("User  : %s\n", userId)
 	fmt.Printf("UID   : %s\n", user.Uid)
+	if user.Disabled {
+		fmt.Printf("Status: disabled\n")
+	}
 	for _, group := range user.Groups {
 		fmt.Printf("Group : %-5s\n", group.GroupID)
 	}
@@ -609,7 +617,8 @@ func queryAndPrintUser(ctx context.Context, txn *dgo.Txn, userId string) error {
 
 func queryAndPrintGroup(ctx context.Context, txn *dgo.Txn, groupId string) error {
 	group, err := queryGroup(ctx, txn, groupId, "dgraph.xid", "~dgraph.user.group{dgraph.xid}",
-		"dgraph.acl.rule{dgraph.rule.predicate, dgraph.rule.permission}")
+		"dgraph.acl.rule{dgraph.rule.predicate, dgraph.rule.permission, dgraph.rule.deny, "+
+			"dgraph.rule.filter}", "dgraph.group.default_permission")
 	if err != nil {
 		return err
 	}
@@ -626,6 +635,7 @@ func queryAndPrintGroup(ctx context.Context, txn *dgo.Txn, groupId string) error
 		userNames = append(userNames, user.UserID)
 	}
 	fmt.Printf("Users: %s\n", strings.Join(userNames, " "))
+	fmt.Printf("Default permission: %d\n", group.DefaultPerm)
 
 	for _, acl := range group.Rules {
 		fmt.Printf("ACL: %v\n", acl)
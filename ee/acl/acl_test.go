@@ -21,6 +21,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"os/exec"
+	"strings"
 	"testing"
 	"time"
 
@@ -30,6 +31,9 @@ import (
 	"github.com/dgraph-io/dgraph/x"
 	"github.com/golang/glog"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 var (
@@ -130,6 +134,128 @@ func TestCreateAndDeleteUsers(t *testing.T) {
 	checkUserCount(t, resp, 1)
 }
 
+func TestCaseInsensitiveUserNames(t *testing.T) {
+	accessJwt, _, err := testutil.HttpLogin(&testutil.LoginParams{
+		Endpoint: adminEndpoint,
+		UserID:   "groot",
+		Passwd:   "password",
+	})
+	require.NoError(t, err, "login failed")
+
+	deleteUser(t, accessJwt, "bob")
+	defer deleteUser(t, accessJwt, "bob")
+
+	resp := createUser(t, accessJwt, "Bob", userpassword)
+	require.JSONEq(t, `{"data":{"addUser":{"user":[{"name":"bob"}]}}}`, string(resp))
+
+	// A case variant of an existing user name collides with it.
+	resp = createUser(t, accessJwt, "BOB", userpassword)
+	checkUserCount(t, resp, 0)
+
+	// The user can log in using any case variant of the user name.
+	_, _, err = testutil.HttpLogin(&testutil.LoginParams{
+		Endpoint: adminEndpoint,
+		UserID:   "bOb",
+		Passwd:   userpassword,
+	})
+	require.NoError(t, err, "login failed")
+}
+
+func TestExistingMixedCaseUser(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	accessJwt, _ := testutil.GrootHttpLogin(adminEndpoint)
+	dg, err := testutil.DgraphClientWithGroot(testutil.SockAddr)
+	require.NoError(t, err)
+
+	// Users created before user names were case-insensitive can have upper case letters in their
+	// names, which addUser doesn't allow anymore.
+	deleteUser(t, accessJwt, "Alice")
+	defer deleteUser(t, accessJwt, "Alice")
+	_, err = dg.NewTxn().Mutate(ctx, &api.Mutation{
+		SetNquads: []byte(fmt.Sprintf(`_:alice <dgraph.xid> "Alice" .
+			_:alice <dgraph.password> "%s" .
+			_:alice <dgraph.type> "User" .`, userpassword)),
+		CommitNow: true,
+	})
+	require.NoError(t, err)
+
+	// They can still log in and be managed using their exact names.
+	_, _, err = testutil.HttpLogin(&testutil.LoginParams{
+		Endpoint: adminEndpoint,
+		UserID:   "Alice",
+		Passwd:   userpassword,
+	})
+	require.NoError(t, err, "login failed")
+
+	b := updateUserPassword(t, accessJwt, "Alice", "newpassword", "newpassword")
+	require.JSONEq(t, `{"data":{"updateUserPassword":{"user":[{"name":"Alice"}]}}}`, string(b))
+	_, _, err = testutil.HttpLogin(&testutil.LoginParams{
+		Endpoint: adminEndpoint,
+		UserID:   "Alice",
+		Passwd:   "newpassword",
+	})
+	require.NoError(t, err, "login failed")
+
+	// They are recognized as guardians by their exact names too.
+	addToGroup(t, accessJwt, "Alice", "guardians")
+	b = copyUserGroups(t, accessJwt, "Alice", "groot", false)
+	require.Contains(t, string(b), "the groups of Alice can't be copied because it is a member "+
+		"of the guardians group")
+}
+
+func TestACLAudit(t *testing.T) {
+	accessJwt, _, err := testutil.HttpLogin(&testutil.LoginParams{
+		Endpoint: adminEndpoint,
+		UserID:   "groot",
+		Passwd:   "password",
+	})
+	require.NoError(t, err, "login failed")
+
+	since := time.Now().UTC().Add(-time.Second).Format(time.RFC3339)
+	deleteUser(t, accessJwt, "carol")
+	resp := createUser(t, accessJwt, "carol", userpassword)
+	checkUserCount(t, resp, 1)
+	defer deleteUser(t, accessJwt, "carol")
+
+	params := testutil.GraphQLParams{
+		Query: `query queryACLAudit($since: String) {
+			queryACLAudit(since: $since) {
+				actor
+				action
+				target
+			}
+		}`,
+		Variables: map[string]interface{}{"since": since},
+	}
+	b := makeRequest(t, accessJwt, params)
+
+	var r struct {
+		Data struct {
+			QueryACLAudit []struct {
+				Actor  string
+				Action string
+				Target string
+			}
+		}
+	}
+	require.NoError(t, json.Unmarshal(b, &r))
+
+	found := false
+	for _, entry := range r.Data.QueryACLAudit {
+		if entry.Action == "addUser" && strings.Contains(entry.Target, "carol") {
+			require.Equal(t, "groot", entry.Actor)
+			found = true
+		}
+	}
+	require.True(t, found, "addUser of carol wasn't audited: %s", string(b))
+
+	// Entries from the future don't exist yet.
+	params.Variables["since"] = time.Now().UTC().Add(time.Hour).Format(time.RFC3339)
+	b = makeRequest(t, accessJwt, params)
+	require.JSONEq(t, `{"data":{"queryACLAudit":[]}}`, string(b))
+}
+
 func resetUser(t *testing.T) {
 	accessJwt, _, err := testutil.HttpLogin(&testutil.LoginParams{
 		Endpoint: adminEndpoint,
@@ -377,6 +503,46 @@ func createGroup(t *testing.T, accessToken, name string) []byte {
 	return b
 }
 
+func createGroupWithRules(t *testing.T, accessToken, name string, rules []rule) []byte {
+	addGroup := `mutation addGroup($name: String!, $rules: [RuleRef]) {
+		addGroup(input: [{name: $name, rules: $rules}]) {
+			group {
+				name
+				rules {
+					predicate
+					permission
+				}
+			}
+		}
+	}`
+
+	params := testutil.GraphQLParams{
+		Query: addGroup,
+		Variables: map[string]interface{}{
+			"name":  name,
+			"rules": rules,
+		},
+	}
+	return makeRequest(t, accessToken, params)
+}
+
+func deleteGroup(t *testing.T, accessToken, name string) {
+	delGroup := `mutation deleteGroup($name: String!) {
+		deleteGroup(filter: {name: {eq: $name}}) {
+			msg
+		}
+	}`
+
+	params := testutil.GraphQLParams{
+		Query: delGroup,
+		Variables: map[string]interface{}{
+			"name": name,
+		},
+	}
+	b := makeRequest(t, accessToken, params)
+	require.JSONEq(t, `{"data":{"deleteGroup":{"msg":"Deleted"}}}`, string(b))
+}
+
 func checkGroupCount(t *testing.T, resp []byte, expected int) {
 	type Response struct {
 		Data struct {
@@ -436,8 +602,11 @@ type rule struct {
 }
 
 func makeRequest(t *testing.T, accessToken string, params testutil.GraphQLParams) []byte {
-	adminUrl := "http://" + testutil.SockAddrHttp + "/admin"
+	return makeRequestTo(t, "http://"+testutil.SockAddrHttp+"/admin", accessToken, params)
+}
 
+func makeRequestTo(t *testing.T, adminUrl, accessToken string,
+	params testutil.GraphQLParams) []byte {
 	b, err := json.Marshal(params)
 	require.NoError(t, err)
 
@@ -766,20 +935,28 @@ func removeUserFromGroup(t *testing.T, userName, groupName string) []byte {
 	return b
 }
 
-func TestQueryRemoveUnauthorizedPred(t *testing.T) {
-	ctx, _ := context.WithTimeout(context.Background(), 100*time.Second)
-
-	dg, err := testutil.DgraphClientWithGroot(testutil.SockAddr)
-	require.NoError(t, err)
+func removeUserFromAllGroups(t *testing.T, accessToken, userName string) []byte {
+	removeAllGroups := `mutation removeUserFromAllGroups($name: String!) {
+		removeUserFromAllGroups(name: $name) {
+			user {
+				name
+				groups {
+					name
+				}
+			}
+		}
+	}`
 
-	testutil.DropAll(t, dg)
-	op := api.Operation{Schema: `
-		name	 : string @index(exact) .
-		nickname : string @index(exact) .
-		age 	 : int .
-	`}
-	require.NoError(t, dg.Alter(ctx, &op))
+	params := testutil.GraphQLParams{
+		Query: removeAllGroups,
+		Variables: map[string]interface{}{
+			"name": userName,
+		},
+	}
+	return makeRequest(t, accessToken, params)
+}
 
+func TestRemoveUserFromAllGroups(t *testing.T) {
 	resetUser(t)
 	accessJwt, _, err := testutil.HttpLogin(&testutil.LoginParams{
 		Endpoint: adminEndpoint,
@@ -787,329 +964,1621 @@ func TestQueryRemoveUnauthorizedPred(t *testing.T) {
 		Passwd:   "password",
 	})
 	require.NoError(t, err, "login failed")
-	createGroup(t, accessJwt, devGroup)
-	addToGroup(t, accessJwt, userid, devGroup)
-
-	txn := dg.NewTxn()
-	mutation := &api.Mutation{
-		SetNquads: []byte(`
-			_:a <name> "RandomGuy" .
-			_:a <age> "23" .
-			_:a <nickname> "RG" .
-			_:b <name> "RandomGuy2" .
-			_:b <age> "25" .
-			_:b <nickname> "RG2" .
-		`),
-		CommitNow: true,
-	}
-	_, err = txn.Mutate(ctx, mutation)
-	require.NoError(t, err)
-
-	// give read access of <name> to alice
-	addRulesToGroup(t, accessJwt, devGroup, []rule{{"name", Read.Code}})
 
-	userClient, err := testutil.DgraphClient(testutil.SockAddr)
-	require.NoError(t, err)
-	time.Sleep(6 * time.Second)
-
-	err = userClient.Login(ctx, userid, userpassword)
-	require.NoError(t, err)
-
-	tests := []struct {
-		input       string
-		output      string
-		description string
-	}{
-		{
-			`
-			{
-				me(func: has(name)) {
-					name
-					age
+	addUserToGroups := `mutation updateUser($name: String!) {
+		updateUser(input: {
+			filter: {
+				name: {
+					eq: $name
 				}
+			},
+			set: {
+				groups: [
+					{ name: "dev" },
+					{ name: "sre" },
+					{ name: "qa" }
+				]
 			}
-			`,
-			`{"me":[{"name":"RandomGuy"},{"name":"RandomGuy2"}]}`,
-			"alice doesn't have access to <age>",
-		},
-		{
-			`
-			{
-				me(func: has(age)) {
+		}) {
+			user {
+				name
+				groups {
 					name
-					age
 				}
 			}
-			`,
-			`{}`,
-			`alice doesn't have access to <age> so "has(age)" is unauthorized`,
+		}
+	}`
+	params := testutil.GraphQLParams{
+		Query: addUserToGroups,
+		Variables: map[string]interface{}{
+			"name": userid,
 		},
-		{
-			`
-			{
-				me1(func: has(name), orderdesc: age) {
-					name
-					age
-				}
-				me2(func: has(name), orderasc: age) {
+	}
+	b := makeRequest(t, accessJwt, params)
+	testutil.CompareJSON(t, fmt.Sprintf(`{"data":{"updateUser":{"user":[{"name":"%s",
+		"groups":[{"name":"dev"},{"name":"sre"},{"name":"qa"}]}]}}}`, userid), string(b))
+
+	b = removeUserFromAllGroups(t, accessJwt, userid)
+	expectedOutput := fmt.Sprintf(
+		`{"data":{"removeUserFromAllGroups":{"user":[{"name":"%s","groups":[]}]}}}`, userid)
+	require.JSONEq(t, expectedOutput, string(b))
+}
+
+func copyUserGroups(t *testing.T, accessToken, from, to string, replace bool) []byte {
+	params := testutil.GraphQLParams{
+		Query: `mutation copyUserGroups($from: String!, $to: String!, $replace: Boolean) {
+			copyUserGroups(from: $from, to: $to, replace: $replace) {
+				user {
 					name
-					age
-				}
-			}
-			`,
-			`{"me1":[{"name":"RandomGuy"},{"name":"RandomGuy2"}],"me2":[{"name":"RandomGuy"},{"name":"RandomGuy2"}]}`,
-			`me1, me2 will have same results, can't order by <age> since it is unauthorized`,
-		},
-		{
-			`
-			{
-				me(func: has(name)) @groupby(age) {
-					count(name)
+					groups {
+						name
+					}
 				}
 			}
-			`,
-			`{}`,
-			`can't groupby <age> since <age> is unauthorized`,
+		}`,
+		Variables: map[string]interface{}{
+			"from":    from,
+			"to":      to,
+			"replace": replace,
 		},
-		{
-			`
-			{
-				me(func: has(name)) @filter(eq(nickname, "RG")) {
+	}
+	return makeRequest(t, accessToken, params)
+}
+
+func TestCopyUserGroups(t *testing.T) {
+	resetUser(t)
+	accessJwt, _ := testutil.GrootHttpLogin(adminEndpoint)
+
+	b := makeRequest(t, accessJwt, testutil.GraphQLParams{
+		Query: `mutation updateUser($name: String!) {
+			updateUser(input: {
+				filter: { name: { eq: $name } },
+				set: { groups: [{ name: "dev" }, { name: "sre" }, { name: "qa" }] }
+			}) {
+				user {
 					name
-					age
 				}
 			}
-			`,
-			`{"me":[{"name":"RandomGuy"},{"name":"RandomGuy2"}]}`,
-			`filter won't work because <nickname> is unauthorized`,
-		},
-	}
-
-	for _, tc := range tests {
-		t.Run(tc.description, func(t *testing.T) {
-			t.Parallel()
-			resp, err := userClient.NewTxn().Query(ctx, tc.input)
-			require.Nil(t, err)
-			testutil.CompareJSON(t, tc.output, string(resp.Json))
-		})
-	}
+		}`,
+		Variables: map[string]interface{}{"name": userid},
+	})
+	require.JSONEq(t, fmt.Sprintf(`{"data":{"updateUser":{"user":[{"name":"%s"}]}}}`, userid),
+		string(b))
+	deleteUser(t, accessJwt, "bob")
+	checkUserCount(t, createUser(t, accessJwt, "bob", userpassword), 1)
+	defer deleteUser(t, accessJwt, "bob")
+	addToGroup(t, accessJwt, "bob", "support")
+
+	b = copyUserGroups(t, accessJwt, userid, "bob", false)
+	testutil.CompareJSON(t, `{"data":{"copyUserGroups":{"user":[{"name":"bob",
+		"groups":[{"name":"dev"},{"name":"sre"},{"name":"qa"},{"name":"support"}]}]}}}`,
+		string(b))
+
+	b = copyUserGroups(t, accessJwt, userid, "bob", true)
+	testutil.CompareJSON(t, `{"data":{"copyUserGroups":{"user":[{"name":"bob",
+		"groups":[{"name":"dev"},{"name":"sre"},{"name":"qa"}]}]}}}`, string(b))
+
+	// The groups of the guardians can't be copied.
+	b = copyUserGroups(t, accessJwt, "groot", "bob", false)
+	require.Contains(t, string(b), "the groups of groot can't be copied because it is a member "+
+		"of the guardians group")
+	deleteUser(t, accessJwt, "alice")
+	checkUserCount(t, createUser(t, accessJwt, "alice", userpassword), 1)
+	addToGroup(t, accessJwt, "alice", "guardians")
+	b = copyUserGroups(t, accessJwt, "alice", "bob", false)
+	require.Contains(t, string(b), "the groups of alice can't be copied because it is a member "+
+		"of the guardians group")
+	deleteUser(t, accessJwt, "alice")
+
+	// Replacing the groups of the last guardian would remove it from guardians.
+	b = copyUserGroups(t, accessJwt, "bob", "groot", true)
+	require.Contains(t, string(b), "it would remove the last member of the guardians group")
 }
 
-func TestNewACLPredicates(t *testing.T) {
-	ctx, _ := context.WithTimeout(context.Background(), 100*time.Second)
+func TestReadonlyGuardian(t *testing.T) {
+	accessJwt, _ := testutil.GrootHttpLogin(adminEndpoint)
+	deleteUser(t, accessJwt, "auditor")
+	checkUserCount(t, createUser(t, accessJwt, "auditor", "auditorpass"), 1)
+	defer deleteUser(t, accessJwt, "auditor")
+	addToGroup(t, accessJwt, "auditor", "readonly-guardians")
 
-	dg, err := testutil.DgraphClientWithGroot(testutil.SockAddr)
-	require.NoError(t, err)
-	addDataAndRules(ctx, t, dg)
+	auditorJwt, _, err := testutil.HttpLogin(&testutil.LoginParams{
+		Endpoint: adminEndpoint,
+		UserID:   "auditor",
+		Passwd:   "auditorpass",
+	})
+	require.NoError(t, err, "login failed")
 
-	userClient, err := testutil.DgraphClient(testutil.SockAddr)
-	require.NoError(t, err)
-	time.Sleep(6 * time.Second)
+	// Readonly guardians can see all the users, not only themselves.
+	names := queryUserNames(t, auditorJwt, nil)
+	require.Contains(t, names, "groot")
+	require.Contains(t, names, "auditor")
 
-	err = userClient.Login(ctx, userid, userpassword)
-	require.NoError(t, err)
-
-	queryTests := []struct {
-		input       string
-		output      string
-		description string
-	}{
-		{
-			`
-			{
-				me(func: has(name)) {
+	b := makeRequest(t, auditorJwt, testutil.GraphQLParams{
+		Query: `query {
+			getGroup(name: "guardians") {
+				users {
 					name
-					nickname
 				}
 			}
-			`,
-			`{"me":[{"name":"RandomGuy"},{"name":"RandomGuy2"}]}`,
-			"alice doesn't have read access to <nickname>",
-		},
-		{
-			`
-			{
-				me(func: has(nickname)) {
-					name
-					nickname
+		}`,
+	})
+	require.Contains(t, string(b), `"groot"`)
+
+	b = makeRequest(t, auditorJwt, testutil.GraphQLParams{
+		Query: `query {
+			queryACLAudit {
+				action
+			}
+		}`,
+	})
+	require.NotContains(t, string(b), "errors")
+
+	// But they can't modify the ACL data.
+	b = createGroup(t, auditorJwt, "auditor-group")
+	require.Contains(t, string(b), "unauthorized to mutate")
+	b = makeRequest(t, auditorJwt, testutil.GraphQLParams{
+		Query: `mutation {
+			gcACL {
+				response {
+					groups
 				}
 			}
-			`,
-			`{}`,
-			`alice doesn't have access to <nickname> so "has(nickname)" is unauthorized`,
-		},
-	}
+		}`,
+	})
+	require.Contains(t, string(b), "Only guardians are allowed access")
 
-	for _, tc := range queryTests {
-		t.Run(tc.description, func(t *testing.T) {
-			t.Parallel()
-			resp, err := userClient.NewTxn().Query(ctx, tc.input)
-			require.Nil(t, err)
-			testutil.CompareJSON(t, tc.output, string(resp.Json))
-		})
+	// The readonly guardians group can't be deleted, even by guardians.
+	b = makeRequest(t, accessJwt, testutil.GraphQLParams{
+		Query: `mutation {
+			deleteGroup(filter: {name: {eq: "readonly-guardians"}}) {
+				msg
+			}
+		}`,
+	})
+	require.Contains(t, string(b), "the readonly-guardians group can't be deleted")
+}
+
+func queryUserNames(t *testing.T, accessToken string, vars map[string]interface{}) []string {
+	queryUser := `query queryUser($group: String, $first: Int, $offset: Int) {
+		queryUser(group: $group, order: {asc: name}, first: $first, offset: $offset) {
+			name
+		}
+	}`
+
+	params := testutil.GraphQLParams{
+		Query:     queryUser,
+		Variables: vars,
 	}
+	b := makeRequest(t, accessToken, params)
 
-	mutationTests := []struct {
-		input       string
-		output      string
-		err         error
-		description string
-	}{
-		{
-			"_:a <name> \"Animesh\" .",
-			"",
-			errors.New(""),
-			"alice doesn't have write access on <name>.",
-		},
-		{
-			"_:a <nickname> \"Pathak\" .",
-			"",
-			nil,
-			"alice can mutate <nickname> predicate.",
-		},
+	var r struct {
+		Data struct {
+			QueryUser []struct {
+				Name string
+			}
+		}
+		Errors []interface{}
 	}
-	for _, tc := range mutationTests {
-		t.Run(tc.description, func(t *testing.T) {
-			_, err := userClient.NewTxn().Mutate(ctx, &api.Mutation{
-				SetNquads: []byte(tc.input),
-				CommitNow: true,
-			})
-			require.True(t, (err == nil) == (tc.err == nil))
-		})
+	require.NoError(t, json.Unmarshal(b, &r))
+	require.Empty(t, r.Errors)
+	var names []string
+	for _, u := range r.Data.QueryUser {
+		names = append(names, u.Name)
 	}
+	return names
 }
 
-func removeRuleFromGroup(t *testing.T, accessToken, group string, ruleID string) []byte {
-	removeRuleFromGroup := `mutation updateGroup($name: String!, $rules: [RuleRef]) {
-		updateGroup(input: {
-			filter: {
-				name: {
-					eq: $name
-				}
-			},
-			remove: {
-				rules: $rules
+func TestQueryUserByGroup(t *testing.T) {
+	dg, err := testutil.DgraphClientWithGroot(testutil.SockAddr)
+	require.NoError(t, err)
+	testutil.DropAll(t, dg)
+	resetUser(t)
+
+	accessJwt, _, err := testutil.HttpLogin(&testutil.LoginParams{
+		Endpoint: adminEndpoint,
+		UserID:   "groot",
+		Passwd:   "password",
+	})
+	require.NoError(t, err, "login failed")
+
+	deleteUser(t, accessJwt, "bob")
+	checkUserCount(t, createUser(t, accessJwt, "bob", userpassword), 1)
+	defer deleteUser(t, accessJwt, "bob")
+
+	checkGroupCount(t, createGroup(t, accessJwt, devGroup), 1)
+	require.Empty(t, queryUserNames(t, accessJwt, map[string]interface{}{"group": devGroup}))
+
+	addToGroup(t, accessJwt, userid, devGroup)
+	require.Equal(t, []string{userid},
+		queryUserNames(t, accessJwt, map[string]interface{}{"group": devGroup}))
+	require.Empty(t, queryUserNames(t, accessJwt, map[string]interface{}{
+		"group":  devGroup,
+		"offset": 1,
+	}))
+
+	// Without a group, every user is returned.
+	require.Subset(t, queryUserNames(t, accessJwt, nil), []string{"bob", userid, "groot"})
+}
+
+func aggregateUserCount(t *testing.T, accessToken string) int {
+	params := testutil.GraphQLParams{
+		Query: `query {
+			aggregateUser {
+				count
 			}
-		}) {
-			group {
+		}`,
+	}
+	b := makeRequest(t, accessToken, params)
+
+	var r struct {
+		Data struct {
+			AggregateUser struct {
+				Count int
+			}
+		}
+	}
+	require.NoError(t, json.Unmarshal(b, &r))
+	return r.Data.AggregateUser.Count
+}
+
+func TestQueryUserPagination(t *testing.T) {
+	accessJwt, _, err := testutil.HttpLogin(&testutil.LoginParams{
+		Endpoint: adminEndpoint,
+		UserID:   "groot",
+		Passwd:   "password",
+	})
+	require.NoError(t, err, "login failed")
+
+	users := []string{"pageuser1", "pageuser2", "pageuser3", "pageuser4", "pageuser5"}
+	for _, u := range users {
+		deleteUser(t, accessJwt, u)
+	}
+	countBefore := aggregateUserCount(t, accessJwt)
+	for _, u := range users {
+		resp := createUser(t, accessJwt, u, userpassword)
+		checkUserCount(t, resp, 1)
+	}
+	defer func() {
+		for _, u := range users {
+			deleteUser(t, accessJwt, u)
+		}
+	}()
+
+	total := aggregateUserCount(t, accessJwt)
+	require.Equal(t, countBefore+len(users), total)
+
+	// Without first and offset, all the users are returned.
+	all := queryUserNames(t, accessJwt, nil)
+	require.Len(t, all, total)
+	for _, u := range users {
+		require.Contains(t, all, u)
+	}
+
+	page := queryUserNames(t, accessJwt, map[string]interface{}{"first": 2, "offset": 1})
+	require.Equal(t, all[1:3], page)
+
+	// Paging past the end returns only the remaining users.
+	page = queryUserNames(t, accessJwt, map[string]interface{}{"first": 10, "offset": total - 1})
+	require.Equal(t, all[total-1:], page)
+}
+
+func TestQueryUserPageCursor(t *testing.T) {
+	accessJwt, _, err := testutil.HttpLogin(&testutil.LoginParams{
+		Endpoint: adminEndpoint,
+		UserID:   "groot",
+		Passwd:   "password",
+	})
+	require.NoError(t, err, "login failed")
+
+	users := []string{"cursoruser1", "cursoruser2", "cursoruser3", "cursoruser4", "cursoruser5"}
+	for _, u := range users {
+		deleteUser(t, accessJwt, u)
+		checkUserCount(t, createUser(t, accessJwt, u, userpassword), 1)
+	}
+	defer func() {
+		for _, u := range users {
+			deleteUser(t, accessJwt, u)
+		}
+	}()
+
+	queryUserPage := `query queryUserPage($first: Int, $after: String) {
+		queryUserPage(first: $first, after: $after) {
+			users {
 				name
-				rules {
-					predicate
-					permission
-				}
 			}
+			cursor
 		}
 	}`
+	var names []string
+	seen := make(map[string]bool)
+	vars := map[string]interface{}{"first": 2}
+	for pages := 0; ; pages++ {
+		require.True(t, pages <= aggregateUserCount(t, accessJwt), "paging doesn't end")
+
+		b := makeRequest(t, accessJwt, testutil.GraphQLParams{
+			Query:     queryUserPage,
+			Variables: vars,
+		})
+		var r struct {
+			Data struct {
+				QueryUserPage struct {
+					Users []struct {
+						Name string
+					}
+					Cursor *string
+				}
+			}
+		}
+		require.NoError(t, json.Unmarshal(b, &r), string(b))
+		require.True(t, len(r.Data.QueryUserPage.Users) <= 2)
+		for _, u := range r.Data.QueryUserPage.Users {
+			require.False(t, seen[u.Name], "user %s was returned twice", u.Name)
+			seen[u.Name] = true
+			names = append(names, u.Name)
+		}
+		if r.Data.QueryUserPage.Cursor == nil {
+			break
+		}
+		vars["after"] = *r.Data.QueryUserPage.Cursor
+	}
+
+	require.Len(t, names, aggregateUserCount(t, accessJwt))
+	for _, u := range users {
+		require.Contains(t, names, u)
+	}
+}
 
+func updateUserPassword(t *testing.T, accessToken, name, password, confirm string) []byte {
 	params := testutil.GraphQLParams{
-		Query: removeRuleFromGroup,
+		Query: `mutation updateUserPassword($name: String!, $pass: String!, $confirm: String!) {
+			updateUserPassword(name: $name, password: $pass, confirmPassword: $confirm) {
+				user {
+					name
+				}
+			}
+		}`,
 		Variables: map[string]interface{}{
-			"name":  group,
-			"rules": []map[string]interface{}{{"id": ruleID}},
+			"name":    name,
+			"pass":    password,
+			"confirm": confirm,
 		},
 	}
-	b := makeRequest(t, accessToken, params)
-	return b
+	return makeRequest(t, accessToken, params)
 }
 
-func TestNegativePermissionDeleteRule(t *testing.T) {
-	ctx, _ := context.WithTimeout(context.Background(), 100*time.Second)
-
-	dg, err := testutil.DgraphClientWithGroot(testutil.SockAddr)
-	require.NoError(t, err)
-	assigned := addDataAndRules(ctx, t, dg)
-
-	userClient, err := testutil.DgraphClient(testutil.SockAddr)
-	require.NoError(t, err)
-	time.Sleep(6 * time.Second)
-
-	err = userClient.Login(ctx, userid, userpassword)
-	require.NoError(t, err)
-
-	queryName := "{me(func: has(name)) {name}}"
-	resp, err := userClient.NewReadOnlyTxn().Query(ctx, queryName)
-	require.NoError(t, err, "Error while querying data")
+func TestRotateGrootPassword(t *testing.T) {
+	accessJwt, _, err := testutil.HttpLogin(&testutil.LoginParams{
+		Endpoint: adminEndpoint,
+		UserID:   "groot",
+		Passwd:   "password",
+	})
+	require.NoError(t, err, "login failed")
 
-	testutil.CompareJSON(t, `{"me":[{"name":"RandomGuy"},{"name":"RandomGuy2"}]}`,
-		string(resp.GetJson()))
+	// A mistyped confirmation doesn't change the password.
+	resp := updateUserPassword(t, accessJwt, "groot", "newpassword", "newpasswrod")
+	require.Contains(t, string(resp), "password and confirmPassword don't match")
+	_, _, err = testutil.HttpLogin(&testutil.LoginParams{
+		Endpoint: adminEndpoint,
+		UserID:   "groot",
+		Passwd:   "password",
+	})
+	require.NoError(t, err, "login with the old password failed")
+
+	resp = updateUserPassword(t, accessJwt, "groot", "newpassword", "newpassword")
+	require.JSONEq(t, `{"data":{"updateUserPassword":{"user":[{"name":"groot"}]}}}`,
+		string(resp))
+	defer func() {
+		resp := updateUserPassword(t, accessJwt, "groot", "password", "password")
+		require.JSONEq(t, `{"data":{"updateUserPassword":{"user":[{"name":"groot"}]}}}`,
+			string(resp))
+	}()
+
+	_, _, err = testutil.HttpLogin(&testutil.LoginParams{
+		Endpoint: adminEndpoint,
+		UserID:   "groot",
+		Passwd:   "newpassword",
+	})
+	require.NoError(t, err, "login with the new password failed")
+	_, _, err = testutil.HttpLogin(&testutil.LoginParams{
+		Endpoint: adminEndpoint,
+		UserID:   "groot",
+		Passwd:   "password",
+	})
+	require.Error(t, err, "login with the old password should fail")
+}
 
+func TestLastGuardianIsProtected(t *testing.T) {
 	accessJwt, _, err := testutil.HttpLogin(&testutil.LoginParams{
 		Endpoint: adminEndpoint,
 		UserID:   "groot",
 		Passwd:   "password",
 	})
 	require.NoError(t, err, "login failed")
-	removeRuleFromGroup(t, accessJwt, devGroup, assigned["r1"])
-	time.Sleep(6 * time.Second)
 
-	resp, err = userClient.NewReadOnlyTxn().Query(ctx, queryName)
-	require.NoError(t, err, "Error while querying data")
-	testutil.CompareJSON(t, string(resp.GetJson()), `{}`)
+	// The filter matches every user, so removing them from guardians would leave it empty.
+	params := testutil.GraphQLParams{
+		Query: `mutation {
+			updateUser(input: {
+				filter: {not: {name: {eq: "no-such-user"}}},
+				remove: {groups: [{name: "guardians"}]}
+			}) {
+				user {
+					name
+				}
+			}
+		}`,
+	}
+	b := makeRequest(t, accessJwt, params)
+	require.Contains(t, string(b), "it would remove the last member of the guardians group")
+
+	params = testutil.GraphQLParams{
+		Query: `mutation {
+			deleteUser(filter: {name: {eq: "groot"}}) {
+				msg
+			}
+		}`,
+	}
+	b = makeRequest(t, accessJwt, params)
+	require.Contains(t, string(b), "the groot user can't be deleted")
+
+	b = removeUserFromAllGroups(t, accessJwt, "groot")
+	require.Contains(t, string(b), "it would remove the last member of the guardians group")
+
+	// groot is still a guardian.
+	params = testutil.GraphQLParams{
+		Query: `query {
+			getUser(name: "groot") {
+				name
+				groups {
+					name
+				}
+			}
+		}`,
+	}
+	b = makeRequest(t, accessJwt, params)
+	require.JSONEq(t, `{"data":{"getUser":{"name":"groot","groups":[{"name":"guardians"}]}}}`,
+		string(b))
 }
 
-func addDataAndRules(ctx context.Context, t *testing.T, dg *dgo.Dgraph) map[string]string {
+func TestQueryRemoveUnauthorizedPred(t *testing.T) {
+	ctx, _ := context.WithTimeout(context.Background(), 100*time.Second)
+
+	dg, err := testutil.DgraphClientWithGroot(testutil.SockAddr)
+	require.NoError(t, err)
+
 	testutil.DropAll(t, dg)
 	op := api.Operation{Schema: `
 		name	 : string @index(exact) .
 		nickname : string @index(exact) .
+		age 	 : int .
 	`}
 	require.NoError(t, dg.Alter(ctx, &op))
 
 	resetUser(t)
+	accessJwt, _, err := testutil.HttpLogin(&testutil.LoginParams{
+		Endpoint: adminEndpoint,
+		UserID:   "groot",
+		Passwd:   "password",
+	})
+	require.NoError(t, err, "login failed")
+	createGroup(t, accessJwt, devGroup)
+	addToGroup(t, accessJwt, userid, devGroup)
 
-	// TODO - We should be adding this data using the GraphQL API.
+	txn := dg.NewTxn()
+	mutation := &api.Mutation{
+		SetNquads: []byte(`
+			_:a <name> "RandomGuy" .
+			_:a <age> "23" .
+			_:a <nickname> "RG" .
+			_:b <name> "RandomGuy2" .
+			_:b <age> "25" .
+			_:b <nickname> "RG2" .
+		`),
+		CommitNow: true,
+	}
+	_, err = txn.Mutate(ctx, mutation)
+	require.NoError(t, err)
+
+	// give read access of <name> to alice
+	addRulesToGroup(t, accessJwt, devGroup, []rule{{"name", Read.Code}})
+
+	userClient, err := testutil.DgraphClient(testutil.SockAddr)
+	require.NoError(t, err)
+	time.Sleep(6 * time.Second)
+
+	err = userClient.Login(ctx, userid, userpassword)
+	require.NoError(t, err)
+
+	tests := []struct {
+		input       string
+		output      string
+		description string
+	}{
+		{
+			`
+			{
+				me(func: has(name)) {
+					name
+					age
+				}
+			}
+			`,
+			`{"me":[{"name":"RandomGuy"},{"name":"RandomGuy2"}]}`,
+			"alice doesn't have access to <age>",
+		},
+		{
+			`
+			{
+				me(func: has(age)) {
+					name
+					age
+				}
+			}
+			`,
+			`{}`,
+			`alice doesn't have access to <age> so "has(age)" is unauthorized`,
+		},
+		{
+			`
+			{
+				me1(func: has(name), orderdesc: age) {
+					name
+					age
+				}
+				me2(func: has(name), orderasc: age) {
+					name
+					age
+				}
+			}
+			`,
+			`{"me1":[{"name":"RandomGuy"},{"name":"RandomGuy2"}],"me2":[{"name":"RandomGuy"},{"name":"RandomGuy2"}]}`,
+			`me1, me2 will have same results, can't order by <age> since it is unauthorized`,
+		},
+		{
+			`
+			{
+				me(func: has(name)) @groupby(age) {
+					count(name)
+				}
+			}
+			`,
+			`{}`,
+			`can't groupby <age> since <age> is unauthorized`,
+		},
+		{
+			`
+			{
+				me(func: has(name)) @filter(eq(nickname, "RG")) {
+					name
+					age
+				}
+			}
+			`,
+			`{"me":[{"name":"RandomGuy"},{"name":"RandomGuy2"}]}`,
+			`filter won't work because <nickname> is unauthorized`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			t.Parallel()
+			resp, err := userClient.NewTxn().Query(ctx, tc.input)
+			require.Nil(t, err)
+			testutil.CompareJSON(t, tc.output, string(resp.Json))
+		})
+	}
+}
+
+func TestStrictACLQuery(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Second)
+	defer cancel()
+
+	dg, err := testutil.DgraphClientWithGroot(testutil.SockAddr)
+	require.NoError(t, err)
+
+	testutil.DropAll(t, dg)
+	op := api.Operation{Schema: `
+		name	 : string @index(exact) .
+		age 	 : int .
+	`}
+	require.NoError(t, dg.Alter(ctx, &op))
+
+	resetUser(t)
+	accessJwt, _, err := testutil.HttpLogin(&testutil.LoginParams{
+		Endpoint: adminEndpoint,
+		UserID:   "groot",
+		Passwd:   "password",
+	})
+	require.NoError(t, err, "login failed")
+	createGroup(t, accessJwt, devGroup)
+	addToGroup(t, accessJwt, userid, devGroup)
+
+	_, err = dg.NewTxn().Mutate(ctx, &api.Mutation{
+		SetNquads: []byte(`
+			_:a <name> "RandomGuy" .
+			_:a <age> "23" .
+			_:b <name> "RandomGuy2" .
+			_:b <age> "25" .
+		`),
+		CommitNow: true,
+	})
+	require.NoError(t, err)
+
+	// give read access of <name> to alice
+	addRulesToGroup(t, accessJwt, devGroup, []rule{{"name", Read.Code}})
+
+	userClient, err := testutil.DgraphClient(testutil.SockAddr)
+	require.NoError(t, err)
+	time.Sleep(6 * time.Second)
+	require.NoError(t, userClient.Login(ctx, userid, userpassword))
+
+	query := `
+	{
+		me(func: has(name), orderdesc: age) {
+			name
+		}
+	}`
+
+	// By default, the ordering by <age> is silently dropped.
+	resp, err := userClient.NewReadOnlyTxn().Query(ctx, query)
+	require.NoError(t, err)
+	testutil.CompareJSON(t, `{"me":[{"name":"RandomGuy"},{"name":"RandomGuy2"}]}`,
+		string(resp.Json))
+
+	strictCtx := metadata.AppendToOutgoingContext(ctx, "strict-acl", "true")
+	_, err = userClient.NewReadOnlyTxn().Query(strictCtx, query)
+	require.Error(t, err)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	require.Contains(t, err.Error(), "unauthorized to query")
+
+	// A strict query that only uses authorized predicates succeeds.
+	resp, err = userClient.NewReadOnlyTxn().Query(strictCtx, `{ me(func: has(name)) { name } }`)
+	require.NoError(t, err)
+	testutil.CompareJSON(t, `{"me":[{"name":"RandomGuy"},{"name":"RandomGuy2"}]}`,
+		string(resp.Json))
+}
+
+func TestNewACLPredicates(t *testing.T) {
+	ctx, _ := context.WithTimeout(context.Background(), 100*time.Second)
+
+	dg, err := testutil.DgraphClientWithGroot(testutil.SockAddr)
+	require.NoError(t, err)
+	addDataAndRules(ctx, t, dg)
+
+	userClient, err := testutil.DgraphClient(testutil.SockAddr)
+	require.NoError(t, err)
+	time.Sleep(6 * time.Second)
+
+	err = userClient.Login(ctx, userid, userpassword)
+	require.NoError(t, err)
+
+	queryTests := []struct {
+		input       string
+		output      string
+		description string
+	}{
+		{
+			`
+			{
+				me(func: has(name)) {
+					name
+					nickname
+				}
+			}
+			`,
+			`{"me":[{"name":"RandomGuy"},{"name":"RandomGuy2"}]}`,
+			"alice doesn't have read access to <nickname>",
+		},
+		{
+			`
+			{
+				me(func: has(nickname)) {
+					name
+					nickname
+				}
+			}
+			`,
+			`{}`,
+			`alice doesn't have access to <nickname> so "has(nickname)" is unauthorized`,
+		},
+	}
+
+	for _, tc := range queryTests {
+		t.Run(tc.description, func(t *testing.T) {
+			t.Parallel()
+			resp, err := userClient.NewTxn().Query(ctx, tc.input)
+			require.Nil(t, err)
+			testutil.CompareJSON(t, tc.output, string(resp.Json))
+		})
+	}
+
+	mutationTests := []struct {
+		input       string
+		output      string
+		err         error
+		description string
+	}{
+		{
+			"_:a <name> \"Animesh\" .",
+			"",
+			errors.New(""),
+			"alice doesn't have write access on <name>.",
+		},
+		{
+			"_:a <nickname> \"Pathak\" .",
+			"",
+			nil,
+			"alice can mutate <nickname> predicate.",
+		},
+	}
+	for _, tc := range mutationTests {
+		t.Run(tc.description, func(t *testing.T) {
+			_, err := userClient.NewTxn().Mutate(ctx, &api.Mutation{
+				SetNquads: []byte(tc.input),
+				CommitNow: true,
+			})
+			require.True(t, (err == nil) == (tc.err == nil))
+		})
+	}
+}
+
+func removeRuleFromGroup(t *testing.T, accessToken, group string, ruleID string) []byte {
+	removeRuleFromGroup := `mutation updateGroup($name: String!, $rules: [RuleRef]) {
+		updateGroup(input: {
+			filter: {
+				name: {
+					eq: $name
+				}
+			},
+			remove: {
+				rules: $rules
+			}
+		}) {
+			group {
+				name
+				rules {
+					predicate
+					permission
+				}
+			}
+		}
+	}`
+
+	params := testutil.GraphQLParams{
+		Query: removeRuleFromGroup,
+		Variables: map[string]interface{}{
+			"name":  group,
+			"rules": []map[string]interface{}{{"id": ruleID}},
+		},
+	}
+	b := makeRequest(t, accessToken, params)
+	return b
+}
+
+func TestNegativePermissionDeleteRule(t *testing.T) {
+	ctx, _ := context.WithTimeout(context.Background(), 100*time.Second)
+
+	dg, err := testutil.DgraphClientWithGroot(testutil.SockAddr)
+	require.NoError(t, err)
+	assigned := addDataAndRules(ctx, t, dg)
+
+	userClient, err := testutil.DgraphClient(testutil.SockAddr)
+	require.NoError(t, err)
+	time.Sleep(6 * time.Second)
+
+	err = userClient.Login(ctx, userid, userpassword)
+	require.NoError(t, err)
+
+	queryName := "{me(func: has(name)) {name}}"
+	resp, err := userClient.NewReadOnlyTxn().Query(ctx, queryName)
+	require.NoError(t, err, "Error while querying data")
+
+	testutil.CompareJSON(t, `{"me":[{"name":"RandomGuy"},{"name":"RandomGuy2"}]}`,
+		string(resp.GetJson()))
+
+	accessJwt, _, err := testutil.HttpLogin(&testutil.LoginParams{
+		Endpoint: adminEndpoint,
+		UserID:   "groot",
+		Passwd:   "password",
+	})
+	require.NoError(t, err, "login failed")
+	removeRuleFromGroup(t, accessJwt, devGroup, assigned["r1"])
+	time.Sleep(6 * time.Second)
+
+	resp, err = userClient.NewReadOnlyTxn().Query(ctx, queryName)
+	require.NoError(t, err, "Error while querying data")
+	testutil.CompareJSON(t, string(resp.GetJson()), `{}`)
+}
+
+func addDataAndRules(ctx context.Context, t *testing.T, dg *dgo.Dgraph) map[string]string {
+	testutil.DropAll(t, dg)
+	op := api.Operation{Schema: `
+		name	 : string @index(exact) .
+		nickname : string @index(exact) .
+	`}
+	require.NoError(t, dg.Alter(ctx, &op))
+
+	resetUser(t)
+
+	// TODO - We should be adding this data using the GraphQL API.
 	devGroupMut := `
 		_:g  <dgraph.xid>        "dev" .
 		_:g  <dgraph.type>       "Group" .
-		_:g  <dgraph.acl.rule>   _:r1 .
-		_:r1 <dgraph.type> "Rule" .
-		_:r1 <dgraph.rule.predicate>  "name" .
-		_:r1 <dgraph.rule.permission> "4" .
-		_:g  <dgraph.acl.rule>   _:r2 .
-		_:r2 <dgraph.type> "Rule" .
-		_:r2 <dgraph.rule.predicate>  "nickname" .
-		_:r2 <dgraph.rule.permission> "2" .
+		_:g  <dgraph.acl.rule>   _:r1 .
+		_:r1 <dgraph.type> "Rule" .
+		_:r1 <dgraph.rule.predicate>  "name" .
+		_:r1 <dgraph.rule.permission> "4" .
+		_:g  <dgraph.acl.rule>   _:r2 .
+		_:r2 <dgraph.type> "Rule" .
+		_:r2 <dgraph.rule.predicate>  "nickname" .
+		_:r2 <dgraph.rule.permission> "2" .
+	`
+	resp, err := dg.NewTxn().Mutate(ctx, &api.Mutation{
+		SetNquads: []byte(devGroupMut),
+		CommitNow: true,
+	})
+	require.NoError(t, err, "Error adding group and permissions")
+
+	idQuery := fmt.Sprintf(`
+	{
+		userid as var(func: eq(dgraph.xid, "%s"))
+		gid as var(func: eq(dgraph.xid, "dev"))
+	}`, userid)
+	addAliceToDevMutation := &api.NQuad{
+		Subject:   "uid(userid)",
+		Predicate: "dgraph.user.group",
+		ObjectId:  "uid(gid)",
+	}
+	_, err = dg.NewTxn().Do(ctx, &api.Request{
+		CommitNow: true,
+		Query:     idQuery,
+		Mutations: []*api.Mutation{
+			{
+				Set: []*api.NQuad{addAliceToDevMutation},
+			},
+		},
+	})
+	require.NoError(t, err, "Error adding user to dev group")
+
+	mutation := &api.Mutation{
+		SetNquads: []byte(`
+			_:a <name> "RandomGuy" .
+			_:a <nickname> "RG" .
+			_:b <name> "RandomGuy2" .
+			_:b <age> "25" .
+			_:b <nickname> "RG2" .
+		`),
+		CommitNow: true,
+	}
+	_, err = dg.NewTxn().Mutate(ctx, mutation)
+	require.NoError(t, err)
+	return resp.GetUids()
+}
+
+// addDataAndRulesWithGraphQL sets up the same data as addDataAndRules, but creates the dev group
+// with its rules and members using the addGroupWithMembers mutation.
+func addDataAndRulesWithGraphQL(ctx context.Context, t *testing.T, dg *dgo.Dgraph,
+	accessJwt string) []byte {
+	testutil.DropAll(t, dg)
+	op := api.Operation{Schema: `
+		name	 : string @index(exact) .
+		nickname : string @index(exact) .
+	`}
+	require.NoError(t, dg.Alter(ctx, &op))
+
+	resetUser(t)
+
+	b := makeRequest(t, accessJwt, testutil.GraphQLParams{
+		Query: `mutation addGroupWithMembers($input: AddGroupWithMembersInput!) {
+			addGroupWithMembers(input: $input) {
+				group {
+					name
+					users {
+						name
+					}
+					rules {
+						predicate
+						permission
+					}
+				}
+			}
+		}`,
+		Variables: map[string]interface{}{"input": map[string]interface{}{
+			"name":  devGroup,
+			"rules": []rule{{"name", Read.Code}, {"nickname", Write.Code}},
+			"users": []string{userid},
+		}},
+	})
+
+	_, err := dg.NewTxn().Mutate(ctx, &api.Mutation{
+		SetNquads: []byte(`
+			_:a <name> "RandomGuy" .
+			_:a <nickname> "RG" .
+			_:b <name> "RandomGuy2" .
+			_:b <age> "25" .
+			_:b <nickname> "RG2" .
+		`),
+		CommitNow: true,
+	})
+	require.NoError(t, err)
+	return b
+}
+
+func TestAddGroupWithMembers(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Second)
+	defer cancel()
+	dg, err := testutil.DgraphClientWithGroot(testutil.SockAddr)
+	require.NoError(t, err)
+	accessJwt, _ := testutil.GrootHttpLogin(adminEndpoint)
+
+	b := addDataAndRulesWithGraphQL(ctx, t, dg, accessJwt)
+	testutil.CompareJSON(t, fmt.Sprintf(`{"data":{"addGroupWithMembers":{"group":[{
+		"name":"dev",
+		"users":[{"name":"%s"}],
+		"rules":[
+			{"predicate":"name","permission":4},
+			{"predicate":"nickname","permission":2}
+		]}]}}}`, userid), string(b))
+
+	// Neither an existing group nor a user that doesn't exist can be given.
+	for _, input := range []map[string]interface{}{
+		{"name": devGroup, "users": []string{userid}},
+		{"name": "sre", "users": []string{userid, "nobody"}},
+	} {
+		b = makeRequest(t, accessJwt, testutil.GraphQLParams{
+			Query: `mutation addGroupWithMembers($input: AddGroupWithMembersInput!) {
+				addGroupWithMembers(input: $input) {
+					group {
+						name
+					}
+				}
+			}`,
+			Variables: map[string]interface{}{"input": input},
+		})
+		require.Contains(t, string(b), "addGroupWithMembers failed")
+	}
+	b = makeRequest(t, accessJwt, testutil.GraphQLParams{
+		Query: `query { getGroup(name: "sre") { name } }`,
+	})
+	testutil.CompareJSON(t, `{"data":{"getGroup":null}}`, string(b))
+
+	// alice has the same access as when the group is set up by addDataAndRules.
+	userClient, err := testutil.DgraphClient(testutil.SockAddr)
+	require.NoError(t, err)
+	time.Sleep(6 * time.Second)
+	require.NoError(t, userClient.Login(ctx, userid, userpassword))
+
+	resp, err := userClient.NewReadOnlyTxn().Query(ctx, `{
+		me(func: has(name)) {
+			name
+			nickname
+		}
+	}`)
+	require.NoError(t, err)
+	testutil.CompareJSON(t, `{"me":[{"name":"RandomGuy"},{"name":"RandomGuy2"}]}`,
+		string(resp.Json))
+
+	resp, err = userClient.NewReadOnlyTxn().Query(ctx, `{ me(func: has(nickname)) { name } }`)
+	require.NoError(t, err)
+	testutil.CompareJSON(t, `{}`, string(resp.Json))
+
+	_, err = userClient.NewTxn().Mutate(ctx, &api.Mutation{
+		SetNquads: []byte(`_:a <name> "Animesh" .`),
+		CommitNow: true,
+	})
+	require.Error(t, err, "alice doesn't have write access on <name>")
+	_, err = userClient.NewTxn().Mutate(ctx, &api.Mutation{
+		SetNquads: []byte(`_:a <nickname> "Pathak" .`),
+		CommitNow: true,
+	})
+	require.NoError(t, err, "alice can mutate <nickname>")
+}
+
+func TestAddGroupWithRules(t *testing.T) {
+	accessJwt, _ := testutil.GrootHttpLogin(adminEndpoint)
+	deleteGroup(t, accessJwt, "ops-rules")
+	defer deleteGroup(t, accessJwt, "ops-rules")
+
+	rules := []rule{{"name", Read.Code}, {"nickname", Read.Code | Write.Code}}
+	b := createGroupWithRules(t, accessJwt, "ops-rules", rules)
+	checkGroupCount(t, b, 1)
+	rulesb, err := json.Marshal(rules)
+	require.NoError(t, err)
+	testutil.CompareJSON(t, fmt.Sprintf(`{"data":{"addGroup":{"group":[{
+		"name":"ops-rules",
+		"rules":%s
+	}]}}}`, rulesb), string(b))
+
+	// The rules are validated like the ones set by updateGroup, and the group isn't created if
+	// any of them is invalid.
+	b = createGroupWithRules(t, accessJwt, "ops-invalid",
+		[]rule{{"name", Read.Code}, {"nickname", 9}})
+	require.Contains(t, string(b), "addGroup failed")
+	require.Contains(t, string(b), "must be between 0 and 7")
+	b = makeRequest(t, accessJwt, testutil.GraphQLParams{
+		Query: `query { getGroup(name: "ops-invalid") { name } }`,
+	})
+	testutil.CompareJSON(t, `{"data":{"getGroup":null}}`, string(b))
+}
+
+func TestSetUserRules(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Second)
+	defer cancel()
+	dg, err := testutil.DgraphClientWithGroot(testutil.SockAddr)
+	require.NoError(t, err)
+	require.NoError(t, dg.Alter(ctx, &api.Operation{Schema: `direct_pred: string .`}))
+	_, err = dg.NewTxn().Mutate(ctx, &api.Mutation{
+		SetNquads: []byte(`_:a <direct_pred> "visible" .`),
+		CommitNow: true,
+	})
+	require.NoError(t, err)
+
+	// alice isn't a member of any group after being reset.
+	resetUser(t)
+	accessJwt, _ := testutil.GrootHttpLogin(adminEndpoint)
+	setUserRules := `mutation setUserRules($name: String!, $rules: [RuleRef!]!) {
+		setUserRules(name: $name, rules: $rules) {
+			user {
+				name
+				groups {
+					name
+				}
+				rules {
+					predicate
+					permission
+				}
+			}
+		}
+	}`
+	b := makeRequest(t, accessJwt, testutil.GraphQLParams{
+		Query: setUserRules,
+		Variables: map[string]interface{}{
+			"name":  userid,
+			"rules": []rule{{"direct_pred", Read.Code}},
+		},
+	})
+	testutil.CompareJSON(t, fmt.Sprintf(`{"data":{"setUserRules":{"user":[{
+		"name":"%s",
+		"groups":[],
+		"rules":[{"predicate":"direct_pred","permission":4}]
+	}]}}}`, userid), string(b))
+
+	userClient, err := testutil.DgraphClient(testutil.SockAddr)
+	require.NoError(t, err)
+	time.Sleep(6 * time.Second)
+	require.NoError(t, userClient.Login(ctx, userid, userpassword))
+
+	resp, err := userClient.NewReadOnlyTxn().Query(ctx, `{
+		me(func: has(direct_pred)) {
+			direct_pred
+		}
+	}`)
+	require.NoError(t, err)
+	testutil.CompareJSON(t, `{"me":[{"direct_pred":"visible"}]}`, string(resp.Json))
+	_, err = userClient.NewTxn().Mutate(ctx, &api.Mutation{
+		SetNquads: []byte(`_:a <direct_pred> "hidden" .`),
+		CommitNow: true,
+	})
+	require.Error(t, err, "alice only has read access on <direct_pred>")
+
+	// The rules of a user that doesn't exist can't be set.
+	b = makeRequest(t, accessJwt, testutil.GraphQLParams{
+		Query: setUserRules,
+		Variables: map[string]interface{}{
+			"name":  "nobody",
+			"rules": []rule{{"direct_pred", Read.Code}},
+		},
+	})
+	require.Contains(t, string(b), "user nobody doesn't exist")
+
+	// Setting no rules revokes the direct grant.
+	b = makeRequest(t, accessJwt, testutil.GraphQLParams{
+		Query: setUserRules,
+		Variables: map[string]interface{}{
+			"name":  userid,
+			"rules": []rule{},
+		},
+	})
+	testutil.CompareJSON(t, fmt.Sprintf(`{"data":{"setUserRules":{"user":[{
+		"name":"%s",
+		"groups":[],
+		"rules":[]
+	}]}}}`, userid), string(b))
+	time.Sleep(6 * time.Second)
+	resp, err = userClient.NewReadOnlyTxn().Query(ctx, `{
+		me(func: has(direct_pred)) {
+			direct_pred
+		}
+	}`)
+	require.NoError(t, err)
+	testutil.CompareJSON(t, `{}`, string(resp.Json))
+}
+
+func TestEffectivePermission(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Second)
+	defer cancel()
+	dg, err := testutil.DgraphClientWithGroot(testutil.SockAddr)
+	require.NoError(t, err)
+
+	// alice is a member of dev, which has a Read rule on name.
+	uids := addDataAndRules(ctx, t, dg)
+
+	// Make alice a member of sre as well, which has a Write and Modify rule on name.
+	sreGroupMut := `
+		_:g  <dgraph.xid>        "sre" .
+		_:g  <dgraph.type>       "Group" .
+		_:g  <dgraph.acl.rule>   _:r3 .
+		_:r3 <dgraph.type> "Rule" .
+		_:r3 <dgraph.rule.predicate>  "name" .
+		_:r3 <dgraph.rule.permission> "3" .
+		uid(userid) <dgraph.user.group> _:g .
 	`
-	resp, err := dg.NewTxn().Mutate(ctx, &api.Mutation{
-		SetNquads: []byte(devGroupMut),
+	resp, err := dg.NewTxn().Do(ctx, &api.Request{
 		CommitNow: true,
+		Query:     fmt.Sprintf(`{ userid as var(func: eq(dgraph.xid, "%s")) }`, userid),
+		Mutations: []*api.Mutation{{SetNquads: []byte(sreGroupMut)}},
 	})
-	require.NoError(t, err, "Error adding group and permissions")
+	require.NoError(t, err, "Error adding sre group and permissions")
 
-	idQuery := fmt.Sprintf(`
-	{
-		userid as var(func: eq(dgraph.xid, "%s"))
-		gid as var(func: eq(dgraph.xid, "dev"))
-	}`, userid)
-	addAliceToDevMutation := &api.NQuad{
-		Subject:   "uid(userid)",
-		Predicate: "dgraph.user.group",
-		ObjectId:  "uid(gid)",
+	accessJwt, _, err := testutil.HttpLogin(&testutil.LoginParams{
+		Endpoint: adminEndpoint,
+		UserID:   "groot",
+		Passwd:   "password",
+	})
+	require.NoError(t, err, "login failed")
+
+	params := testutil.GraphQLParams{
+		Query: `query effectivePermission($user: String!, $predicate: String!) {
+			effectivePermission(user: $user, predicate: $predicate) {
+				user
+				predicate
+				permission
+				grants {
+					group
+					ruleId
+					permission
+				}
+			}
+		}`,
+		Variables: map[string]interface{}{
+			"user":      userid,
+			"predicate": "name",
+		},
+	}
+	b := makeRequest(t, accessJwt, params)
+	testutil.CompareJSON(t, fmt.Sprintf(`{"data":{"effectivePermission":{
+		"user":"%s",
+		"predicate":"name",
+		"permission":7,
+		"grants":[
+			{"group":"dev","ruleId":"%s","permission":4},
+			{"group":"sre","ruleId":"%s","permission":3}
+		]}}}`, userid, uids["r1"], resp.GetUids()["r3"]), string(b))
+
+	// Non guardians can't resolve permissions.
+	aliceJwt, _, err := testutil.HttpLogin(&testutil.LoginParams{
+		Endpoint: adminEndpoint,
+		UserID:   userid,
+		Passwd:   userpassword,
+	})
+	require.NoError(t, err, "login failed")
+	b = makeRequest(t, aliceJwt, params)
+	require.Contains(t, string(b), "Only guardians and readonly guardians are allowed access")
+}
+
+func TestGroupsWithAccessTo(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Second)
+	defer cancel()
+	dg, err := testutil.DgraphClientWithGroot(testutil.SockAddr)
+	require.NoError(t, err)
+
+	// dev has a Read rule on name and a Write rule on nickname.
+	uids := addDataAndRules(ctx, t, dg)
+
+	accessJwt, _, err := testutil.HttpLogin(&testutil.LoginParams{
+		Endpoint: adminEndpoint,
+		UserID:   "groot",
+		Passwd:   "password",
+	})
+	require.NoError(t, err, "login failed")
+
+	checkGroupCount(t, createGroup(t, accessJwt, "sre"), 1)
+	addRulesToGroup(t, accessJwt, "sre", []rule{{"name", Write.Code | Modify.Code}})
+
+	params := testutil.GraphQLParams{
+		Query: `query groupsWithAccessTo($predicate: String!) {
+			groupsWithAccessTo(predicate: $predicate) {
+				group
+				permission
+			}
+		}`,
+		Variables: map[string]interface{}{"predicate": "name"},
+	}
+	b := makeRequest(t, accessJwt, params)
+	testutil.CompareJSON(t, `{"data":{"groupsWithAccessTo":[
+		{"group":"dev","permission":4},
+		{"group":"sre","permission":3}
+	]}}`, string(b))
+
+	params.Variables["predicate"] = "nickname"
+	params.Query = `query groupsWithAccessTo($predicate: String!) {
+		groupsWithAccessTo(predicate: $predicate) {
+			group
+			ruleId
+			permission
+		}
+	}`
+	b = makeRequest(t, accessJwt, params)
+	testutil.CompareJSON(t, fmt.Sprintf(`{"data":{"groupsWithAccessTo":[
+		{"group":"dev","ruleId":"%s","permission":2}
+	]}}`, uids["r2"]), string(b))
+}
+
+// queryWithJwt runs the DQL query through the HTTP endpoint of the alpha, with the given access
+// JWT, and returns the data in the response.
+func isPredicateProtected(t *testing.T, accessJwt, predicate string) bool {
+	b := makeRequest(t, accessJwt, testutil.GraphQLParams{
+		Query: `query isPredicateProtected($predicate: String!) {
+			isPredicateProtected(predicate: $predicate)
+		}`,
+		Variables: map[string]interface{}{"predicate": predicate},
+	})
+	var resp struct {
+		Data struct {
+			IsPredicateProtected bool
+		}
+		Errors []interface{}
+	}
+	require.NoError(t, json.Unmarshal(b, &resp))
+	require.Empty(t, resp.Errors)
+	return resp.Data.IsPredicateProtected
+}
+
+func TestIsPredicateProtected(t *testing.T) {
+	accessJwt, _ := testutil.GrootHttpLogin(adminEndpoint)
+
+	createGroup(t, accessJwt, "migration")
+	defer deleteGroup(t, accessJwt, "migration")
+	require.False(t, isPredicateProtected(t, accessJwt, "migrated"))
+
+	addRulesToGroup(t, accessJwt, "migration", []rule{{"migrated", Read.Code}})
+	require.True(t, isPredicateProtected(t, accessJwt, "migrated"))
+	require.False(t, isPredicateProtected(t, accessJwt, "unprotected"))
+}
+
+func queryWithJwt(t *testing.T, accessJwt, query string) []byte {
+	queryUrl := "http://" + testutil.SockAddrHttp + "/query"
+
+	req, err := http.NewRequest(http.MethodPost, queryUrl, bytes.NewBufferString(query))
+	require.NoError(t, err)
+	req.Header.Set("X-Dgraph-AccessToken", accessJwt)
+	req.Header.Set("Content-Type", "application/graphql+-")
+	resp, err := (&http.Client{}).Do(req)
+	require.NoError(t, err)
+
+	defer resp.Body.Close()
+	b, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	var r struct {
+		Data   json.RawMessage
+		Errors []interface{}
+	}
+	require.NoError(t, json.Unmarshal(b, &r))
+	require.Empty(t, r.Errors, string(b))
+	return r.Data
+}
+
+func TestImpersonate(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Second)
+	defer cancel()
+	dg, err := testutil.DgraphClientWithGroot(testutil.SockAddr)
+	require.NoError(t, err)
+
+	// alice is a member of dev, which can read name but not nickname.
+	addDataAndRules(ctx, t, dg)
+	time.Sleep(6 * time.Second)
+
+	accessJwt, _, err := testutil.HttpLogin(&testutil.LoginParams{
+		Endpoint: adminEndpoint,
+		UserID:   "groot",
+		Passwd:   "password",
+	})
+	require.NoError(t, err, "login failed")
+
+	impersonate := func(accessJwt, user string) []byte {
+		params := testutil.GraphQLParams{
+			Query: `mutation impersonate($user: String!) {
+				impersonate(user: $user) {
+					response {
+						accessJWT
+					}
+				}
+			}`,
+			Variables: map[string]interface{}{"user": user},
+		}
+		return makeRequest(t, accessJwt, params)
+	}
+
+	since := time.Now().UTC().Add(-time.Second).Format(time.RFC3339)
+	var r struct {
+		Data struct {
+			Impersonate struct {
+				Response struct {
+					AccessJWT string
+				}
+			}
+		}
+	}
+	b := impersonate(accessJwt, userid)
+	require.NoError(t, json.Unmarshal(b, &r))
+	aliceJwt := r.Data.Impersonate.Response.AccessJWT
+	require.NotEmpty(t, aliceJwt, string(b))
+
+	// The impersonated session sees the same predicates as alice does.
+	query := `{ me(func: has(name), orderasc: name) { name nickname } }`
+	testutil.CompareJSON(t, `{"me":[{"name":"RandomGuy"},{"name":"RandomGuy2"}]}`,
+		string(queryWithJwt(t, aliceJwt, query)))
+	testutil.CompareJSON(t, `{"me":[{"name":"RandomGuy","nickname":"RG"},
+		{"name":"RandomGuy2","nickname":"RG2"}]}`, string(queryWithJwt(t, accessJwt, query)))
+
+	// Only guardians can impersonate users.
+	b = impersonate(aliceJwt, "groot")
+	require.Contains(t, string(b), "Only guardians are allowed access")
+
+	// Impersonation is recorded in the audit log.
+	params := testutil.GraphQLParams{
+		Query: `query queryACLAudit($since: String) {
+			queryACLAudit(since: $since) {
+				actor
+				action
+				target
+			}
+		}`,
+		Variables: map[string]interface{}{"since": since},
+	}
+	b = makeRequest(t, accessJwt, params)
+	require.Contains(t, string(b),
+		fmt.Sprintf(`{"actor":"groot","action":"impersonate","target":"\"%s\""}`, userid))
+}
+
+func TestDenyRuleOverridesGrant(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Second)
+	defer cancel()
+	dg, err := testutil.DgraphClientWithGroot(testutil.SockAddr)
+	require.NoError(t, err)
+
+	// alice is a member of dev, which grants read access to name.
+	addDataAndRules(ctx, t, dg)
+
+	accessJwt, _, err := testutil.HttpLogin(&testutil.LoginParams{
+		Endpoint: adminEndpoint,
+		UserID:   "groot",
+		Passwd:   "password",
+	})
+	require.NoError(t, err, "login failed")
+
+	// quarantine denies read access to name.
+	params := testutil.GraphQLParams{
+		Query: `mutation {
+			addGroup(input: [{
+				name: "quarantine",
+				rules: [{predicate: "name", permission: 4, deny: true}]
+			}]) {
+				group {
+					name
+					rules {
+						predicate
+						permission
+						deny
+					}
+				}
+			}
+		}`,
+	}
+	b := makeRequest(t, accessJwt, params)
+	require.JSONEq(t, `{"data":{"addGroup":{"group":[{"name":"quarantine",
+		"rules":[{"predicate":"name","permission":4,"deny":true}]}]}}}`, string(b))
+
+	userClient, err := testutil.DgraphClient(testutil.SockAddr)
+	require.NoError(t, err)
+	time.Sleep(6 * time.Second)
+	require.NoError(t, userClient.Login(ctx, userid, userpassword))
+
+	query := `{ me(func: has(name), orderasc: name) { name } }`
+	resp, err := userClient.NewTxn().Query(ctx, query)
+	require.NoError(t, err)
+	testutil.CompareJSON(t, `{"me":[{"name":"RandomGuy"},{"name":"RandomGuy2"}]}`,
+		string(resp.Json))
+
+	params = testutil.GraphQLParams{
+		Query: `mutation updateUser($name: String!) {
+			updateUser(input: {
+				filter: {name: {eq: $name}},
+				set: {groups: [{name: "quarantine"}]}
+			}) {
+				user {
+					name
+				}
+			}
+		}`,
+		Variables: map[string]interface{}{"name": userid},
+	}
+	makeRequest(t, accessJwt, params)
+
+	// Wait for the acl cache to be refreshed and log in again to get the new groups in the jwt.
+	time.Sleep(6 * time.Second)
+	require.NoError(t, userClient.Login(ctx, userid, userpassword))
+
+	// The deny rule of quarantine wins over the read access granted by dev.
+	resp, err = userClient.NewTxn().Query(ctx, query)
+	require.NoError(t, err)
+	testutil.CompareJSON(t, `{}`, string(resp.Json))
+}
+
+func TestCopyGroupRules(t *testing.T) {
+	dg, err := testutil.DgraphClientWithGroot(testutil.SockAddr)
+	require.NoError(t, err)
+	testutil.DropAll(t, dg)
+
+	accessJwt, _, err := testutil.HttpLogin(&testutil.LoginParams{
+		Endpoint: adminEndpoint,
+		UserID:   "groot",
+		Passwd:   "password",
+	})
+	require.NoError(t, err, "login failed")
+
+	checkGroupCount(t, createGroup(t, accessJwt, "source"), 1)
+	addRulesToGroup(t, accessJwt, "source", []rule{{"name", Read.Code}, {"nickname", Write.Code}})
+	checkGroupCount(t, createGroup(t, accessJwt, "target"), 1)
+	addRulesToGroup(t, accessJwt, "target", []rule{{"name", Modify.Code}})
+
+	copyRules := func(overwrite bool) []byte {
+		params := testutil.GraphQLParams{
+			Query: `mutation copyGroupRules($overwrite: Boolean) {
+				copyGroupRules(from: "source", to: "target", overwrite: $overwrite) {
+					group {
+						name
+						rules {
+							predicate
+							permission
+						}
+					}
+				}
+			}`,
+			Variables: map[string]interface{}{"overwrite": overwrite},
+		}
+		return makeRequest(t, accessJwt, params)
+	}
+
+	// Without overwrite, the existing rule for name is kept.
+	testutil.CompareJSON(t, `{"data":{"copyGroupRules":{"group":[{"name":"target","rules":[
+		{"predicate":"name","permission":1},
+		{"predicate":"nickname","permission":2}]}]}}}`, string(copyRules(false)))
+
+	// With overwrite, the target ends up with the same rules as the source.
+	testutil.CompareJSON(t, `{"data":{"copyGroupRules":{"group":[{"name":"target","rules":[
+		{"predicate":"name","permission":4},
+		{"predicate":"nickname","permission":2}]}]}}}`, string(copyRules(true)))
+
+	// Rules with a permission outside [0, 7] are rejected.
+	params := testutil.GraphQLParams{
+		Query: `mutation {
+			updateGroup(input: {
+				filter: {name: {eq: "target"}},
+				set: {rules: [{predicate: "name", permission: 8}]}
+			}) {
+				group {
+					name
+				}
+			}
+		}`,
+	}
+	resp := makeRequest(t, accessJwt, params)
+	require.Contains(t, string(resp), "must be between 0 and 7")
+}
+
+func TestSetGroupRules(t *testing.T) {
+	dg, err := testutil.DgraphClientWithGroot(testutil.SockAddr)
+	require.NoError(t, err)
+	testutil.DropAll(t, dg)
+
+	accessJwt, _, err := testutil.HttpLogin(&testutil.LoginParams{
+		Endpoint: adminEndpoint,
+		UserID:   "groot",
+		Passwd:   "password",
+	})
+	require.NoError(t, err, "login failed")
+
+	checkGroupCount(t, createGroup(t, accessJwt, devGroup), 1)
+	addRulesToGroup(t, accessJwt, devGroup, []rule{{"name", Read.Code}, {"nickname", Write.Code}})
+
+	params := testutil.GraphQLParams{
+		Query: `mutation setGroupRules($name: String!, $rules: [RuleRef!]!) {
+			setGroupRules(name: $name, rules: $rules) {
+				group {
+					name
+					rules {
+						predicate
+						permission
+					}
+				}
+			}
+		}`,
+		Variables: map[string]interface{}{
+			"name":  devGroup,
+			"rules": []rule{{"name", Read.Code | Write.Code}, {"age", Read.Code}},
+		},
+	}
+	b := makeRequest(t, accessJwt, params)
+
+	// The rule for nickname is removed, the one for name is updated and the one for age added.
+	testutil.CompareJSON(t, fmt.Sprintf(`{"data":{"setGroupRules":{"group":[{"name":"%s",
+		"rules":[
+			{"predicate":"age","permission":4},
+			{"predicate":"name","permission":6}
+		]}]}}}`, devGroup), string(b))
+}
+
+func TestHealthACLStatus(t *testing.T) {
+	accessJwt, _, err := testutil.HttpLogin(&testutil.LoginParams{
+		Endpoint: adminEndpoint,
+		UserID:   "groot",
+		Passwd:   "password",
+	})
+	require.NoError(t, err, "login failed")
+
+	// Wait for the acl cache to be refreshed with the users and groups left by the other tests.
+	time.Sleep(6 * time.Second)
+
+	params := testutil.GraphQLParams{
+		Query: `query {
+			health {
+				acl {
+					enabled
+					lastCacheRefresh
+					userCount
+					groupCount
+				}
+			}
+			aggregateGroup {
+				count
+			}
+		}`,
+	}
+	b := makeRequest(t, accessJwt, params)
+
+	type aclStatus struct {
+		Enabled          bool
+		LastCacheRefresh int64
+		UserCount        int
+		GroupCount       int
+	}
+	var r struct {
+		Data struct {
+			Health []struct {
+				Acl *aclStatus
+			}
+			AggregateGroup struct {
+				Count int
+			}
+		}
 	}
-	_, err = dg.NewTxn().Do(ctx, &api.Request{
-		CommitNow: true,
-		Query:     idQuery,
-		Mutations: []*api.Mutation{
-			{
-				Set: []*api.NQuad{addAliceToDevMutation},
-			},
-		},
-	})
-	require.NoError(t, err, "Error adding user to dev group")
+	require.NoError(t, json.Unmarshal(b, &r))
 
-	mutation := &api.Mutation{
-		SetNquads: []byte(`
-			_:a <name> "RandomGuy" .
-			_:a <nickname> "RG" .
-			_:b <name> "RandomGuy2" .
-			_:b <age> "25" .
-			_:b <nickname> "RG2" .
-		`),
-		CommitNow: true,
+	var status *aclStatus
+	for _, node := range r.Data.Health {
+		if node.Acl != nil {
+			require.Nil(t, status, "only the alpha serving the request should report ACL")
+			status = node.Acl
+		}
 	}
-	_, err = dg.NewTxn().Mutate(ctx, mutation)
-	require.NoError(t, err)
-	return resp.GetUids()
+	require.NotNil(t, status, string(b))
+	require.True(t, status.Enabled)
+	require.InDelta(t, time.Now().Unix(), status.LastCacheRefresh, 10)
+	require.Equal(t, aggregateUserCount(t, accessJwt), status.UserCount)
+	require.Equal(t, r.Data.AggregateGroup.Count, status.GroupCount)
 }
 
 func TestNonExistentGroup(t *testing.T) {
@@ -1245,3 +2714,544 @@ func TestQueryUserInfo(t *testing.T) {
 	b = makeRequest(t, accessJwt, params)
 	testutil.CompareJSON(t, `{"data": {"getGroup": null}}`, string(b))
 }
+
+func setUserEnabled(t *testing.T, accessToken, userName string, enabled bool) []byte {
+	setEnabled := `mutation setUserEnabled($name: String!, $enabled: Boolean!) {
+		setUserEnabled(name: $name, enabled: $enabled) {
+			user {
+				name
+				disabled
+				groups {
+					name
+				}
+			}
+		}
+	}`
+
+	params := testutil.GraphQLParams{
+		Query: setEnabled,
+		Variables: map[string]interface{}{
+			"name":    userName,
+			"enabled": enabled,
+		},
+	}
+	return makeRequest(t, accessToken, params)
+}
+
+func TestDisabledUserCantLogin(t *testing.T) {
+	resetUser(t)
+	accessJwt, _, err := testutil.HttpLogin(&testutil.LoginParams{
+		Endpoint: adminEndpoint,
+		UserID:   "groot",
+		Passwd:   "password",
+	})
+	require.NoError(t, err, "login failed")
+	createGroup(t, accessJwt, devGroup)
+	addToGroup(t, accessJwt, userid, devGroup)
+
+	b := setUserEnabled(t, accessJwt, userid, false)
+	require.JSONEq(t, fmt.Sprintf(`{"data":{"setUserEnabled":{"user":[{"name":"%s",
+		"disabled":true,"groups":[{"name":"%s"}]}]}}}`, userid, devGroup), string(b))
+
+	dg, err := testutil.DgraphClient(testutil.SockAddr)
+	require.NoError(t, err)
+	err = dg.Login(context.Background(), userid, userpassword)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "is disabled")
+
+	// The user keeps its groups while it's disabled.
+	b = setUserEnabled(t, accessJwt, userid, true)
+	require.JSONEq(t, fmt.Sprintf(`{"data":{"setUserEnabled":{"user":[{"name":"%s",
+		"disabled":false,"groups":[{"name":"%s"}]}]}}}`, userid, devGroup), string(b))
+	require.NoError(t, dg.Login(context.Background(), userid, userpassword))
+
+	b = setUserEnabled(t, accessJwt, x.GrootId, false)
+	require.Contains(t, string(b), "can't be disabled")
+}
+
+func TestLastEnabledGuardianCantBeDisabled(t *testing.T) {
+	accessJwt, _ := testutil.GrootHttpLogin(adminEndpoint)
+	deleteUser(t, accessJwt, "ops")
+	checkUserCount(t, createUser(t, accessJwt, "ops", "opspassword"), 1)
+	defer deleteUser(t, accessJwt, "ops")
+	addToGroup(t, accessJwt, "ops", x.GuardiansId)
+
+	updateGroups := func(accessToken, name, op string) []byte {
+		return makeRequest(t, accessToken, testutil.GraphQLParams{
+			Query: fmt.Sprintf(`mutation updateUser($name: String!) {
+				updateUser(input: {
+					filter: {name: {eq: $name}},
+					%s: {groups: [{name: "guardians"}]}
+				}) {
+					user {
+						name
+					}
+				}
+			}`, op),
+			Variables: map[string]interface{}{"name": name},
+		})
+	}
+
+	// With groot out of guardians, ops is its only member and can't be disabled.
+	opsJwt, _, err := testutil.HttpLogin(&testutil.LoginParams{
+		Endpoint: adminEndpoint,
+		UserID:   "ops",
+		Passwd:   "opspassword",
+	})
+	require.NoError(t, err, "login failed")
+	b := updateGroups(accessJwt, x.GrootId, "remove")
+	require.JSONEq(t, `{"data":{"updateUser":{"user":[{"name":"groot"}]}}}`, string(b))
+	defer updateGroups(opsJwt, x.GrootId, "set")
+
+	b = setUserEnabled(t, opsJwt, "ops", false)
+	require.Contains(t, string(b),
+		"it would disable the last enabled member of the guardians group")
+
+	b = updateGroups(opsJwt, x.GrootId, "set")
+	require.JSONEq(t, `{"data":{"updateUser":{"user":[{"name":"groot"}]}}}`, string(b))
+	b = setUserEnabled(t, accessJwt, "ops", false)
+	require.NotContains(t, string(b), "errors")
+}
+
+func TestRowLevelRuleFilter(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Second)
+	defer cancel()
+
+	dg, err := testutil.DgraphClientWithGroot(testutil.SockAddr)
+	require.NoError(t, err)
+
+	testutil.DropAll(t, dg)
+	op := api.Operation{Schema: `
+		name       : string @index(exact) .
+		department : string @index(exact) .
+		salary     : int .
+	`}
+	require.NoError(t, dg.Alter(ctx, &op))
+
+	resetUser(t)
+	accessJwt, _, err := testutil.HttpLogin(&testutil.LoginParams{
+		Endpoint: adminEndpoint,
+		UserID:   "groot",
+		Passwd:   "password",
+	})
+	require.NoError(t, err, "login failed")
+	createGroup(t, accessJwt, devGroup)
+	addToGroup(t, accessJwt, userid, devGroup)
+
+	_, err = dg.NewTxn().Mutate(ctx, &api.Mutation{
+		SetNquads: []byte(`
+			_:a <name> "Alice" .
+			_:a <department> "eng" .
+			_:a <salary> "100" .
+			_:b <name> "Bob" .
+			_:b <department> "sales" .
+			_:b <salary> "200" .
+		`),
+		CommitNow: true,
+	})
+	require.NoError(t, err)
+
+	// dev can read every name, but only the salaries of the eng department.
+	params := testutil.GraphQLParams{
+		Query: `mutation updateGroup($name: String!, $rules: [RuleRef]) {
+			updateGroup(input: {filter: {name: {eq: $name}}, set: {rules: $rules}}) {
+				group {
+					name
+					rules {
+						predicate
+						permission
+						filter
+					}
+				}
+			}
+		}`,
+		Variables: map[string]interface{}{
+			"name": devGroup,
+			"rules": []map[string]interface{}{
+				{"predicate": "name", "permission": Read.Code},
+				{"predicate": "salary", "permission": Read.Code,
+					"filter": `eq(department, "eng")`},
+			},
+		},
+	}
+	b := makeRequest(t, accessJwt, params)
+	testutil.CompareJSON(t, `{"data":{"updateGroup":{"group":[{"name":"dev","rules":[
+		{"predicate":"name","permission":4,"filter":null},
+		{"predicate":"salary","permission":4,"filter":"eq(department, \"eng\")"}]}]}}}`,
+		string(b))
+
+	userClient, err := testutil.DgraphClient(testutil.SockAddr)
+	require.NoError(t, err)
+	time.Sleep(6 * time.Second)
+	require.NoError(t, userClient.Login(ctx, userid, userpassword))
+
+	resp, err := userClient.NewReadOnlyTxn().Query(ctx, `
+	{
+		salaries(func: has(name), orderasc: name) {
+			name
+			salary
+		}
+		names(func: has(name), orderasc: name) {
+			name
+		}
+	}`)
+	require.NoError(t, err)
+	testutil.CompareJSON(t, `{
+		"salaries": [{"name": "Alice", "salary": 100}],
+		"names": [{"name": "Alice"}, {"name": "Bob"}]
+	}`, string(resp.Json))
+
+	// A rule with a filter that isn't valid is rejected.
+	params.Variables["rules"] = []map[string]interface{}{
+		{"predicate": "salary", "permission": Read.Code, "filter": `eq(department, "eng"`},
+	}
+	b = makeRequest(t, accessJwt, params)
+	require.Contains(t, string(b), "invalid filter")
+}
+
+func TestAddRulesToGroups(t *testing.T) {
+	accessJwt, _, err := testutil.HttpLogin(&testutil.LoginParams{
+		Endpoint: adminEndpoint,
+		UserID:   "groot",
+		Passwd:   "password",
+	})
+	require.NoError(t, err, "login failed")
+
+	groups := []string{"bulk1", "bulk2", "bulk3"}
+	for _, group := range groups {
+		deleteGroup(t, accessJwt, group)
+		createGroup(t, accessJwt, group)
+	}
+	// bulk1 already has a rule for name, which is replaced.
+	addRulesToGroup(t, accessJwt, "bulk1", []rule{{"name", Write.Code}})
+
+	addRules := `mutation addRulesToGroups($names: [String!]!, $rules: [RuleRef!]!) {
+		addRulesToGroups(names: $names, rules: $rules) {
+			group {
+				name
+				rules {
+					predicate
+					permission
+				}
+			}
+		}
+	}`
+	params := testutil.GraphQLParams{
+		Query: addRules,
+		Variables: map[string]interface{}{
+			"names": groups,
+			"rules": []rule{{"name", Read.Code}},
+		},
+	}
+	b := makeRequest(t, accessJwt, params)
+	testutil.CompareJSON(t, `{"data":{"addRulesToGroups":{"group":[
+		{"name":"bulk1","rules":[{"predicate":"name","permission":4}]},
+		{"name":"bulk2","rules":[{"predicate":"name","permission":4}]},
+		{"name":"bulk3","rules":[{"predicate":"name","permission":4}]}]}}}`, string(b))
+
+	getGroup := `query getGroup($name: String!) {
+		getGroup(name: $name) {
+			name
+			rules {
+				predicate
+				permission
+			}
+		}
+	}`
+	for _, group := range groups {
+		b = makeRequest(t, accessJwt, testutil.GraphQLParams{
+			Query:     getGroup,
+			Variables: map[string]interface{}{"name": group},
+		})
+		testutil.CompareJSON(t, fmt.Sprintf(`{"data":{"getGroup":{"name":"%s",
+			"rules":[{"predicate":"name","permission":4}]}}}`, group), string(b))
+	}
+
+	// No group is changed if one of them doesn't exist.
+	params.Variables = map[string]interface{}{
+		"names": []string{"bulk1", "nonexistent"},
+		"rules": []rule{{"age", Read.Code}},
+	}
+	b = makeRequest(t, accessJwt, params)
+	require.Contains(t, string(b), "groups don't exist: nonexistent")
+	b = makeRequest(t, accessJwt, testutil.GraphQLParams{
+		Query:     getGroup,
+		Variables: map[string]interface{}{"name": "bulk1"},
+	})
+	testutil.CompareJSON(t, `{"data":{"getGroup":{"name":"bulk1",
+		"rules":[{"predicate":"name","permission":4}]}}}`, string(b))
+}
+
+type nodeLeadership struct {
+	Instance string
+	Address  string
+	Group    int
+	Leader   bool
+}
+
+func groupOneAlphas(t *testing.T, accessJwt string) []nodeLeadership {
+	b := makeRequest(t, accessJwt, testutil.GraphQLParams{
+		Query: `query {
+			health {
+				instance
+				address
+				group
+				leader
+			}
+		}`,
+	})
+	var resp struct {
+		Data struct {
+			Health []nodeLeadership
+		}
+	}
+	require.NoError(t, json.Unmarshal(b, &resp))
+	var alphas []nodeLeadership
+	for _, node := range resp.Data.Health {
+		if node.Instance == "alpha" && node.Group == 1 {
+			alphas = append(alphas, node)
+		}
+	}
+	return alphas
+}
+
+func TestTransferLeadership(t *testing.T) {
+	accessJwt, _ := testutil.GrootHttpLogin(adminEndpoint)
+
+	var to string
+	for _, node := range groupOneAlphas(t, accessJwt) {
+		if !node.Leader {
+			to = node.Address
+		}
+	}
+	require.NotEmpty(t, to, "group 1 should have a follower")
+
+	b := makeRequest(t, accessJwt, testutil.GraphQLParams{
+		Query: `mutation transferLeadership($to: String!) {
+			transferLeadership(input: {group: 1, to: $to}) {
+				response {
+					leader
+				}
+			}
+		}`,
+		Variables: map[string]interface{}{"to": to},
+	})
+	testutil.CompareJSON(t, fmt.Sprintf(`{"data":{"transferLeadership":
+		{"response":{"leader":"%s"}}}}`, to), string(b))
+
+	// The health query reports the new leader once Zero learns about it.
+	for i := 0; ; i++ {
+		var leaders []string
+		for _, node := range groupOneAlphas(t, accessJwt) {
+			if node.Leader {
+				leaders = append(leaders, node.Address)
+			}
+		}
+		if len(leaders) == 1 && leaders[0] == to {
+			break
+		}
+		require.True(t, i < 30, "health still reports %v as the leaders of group 1", leaders)
+		time.Sleep(time.Second)
+	}
+}
+
+type aclCacheContents struct {
+	LastRefresh int64
+	Groups      []struct {
+		Name  string
+		Rules []rule
+	}
+}
+
+func aclCacheOf(t *testing.T, adminUrl, accessJwt string) aclCacheContents {
+	b := makeRequestTo(t, adminUrl, accessJwt, testutil.GraphQLParams{
+		Query: `query {
+			aclCache {
+				lastRefresh
+				groups {
+					name
+					rules {
+						predicate
+						permission
+					}
+				}
+			}
+		}`,
+	})
+	var resp struct {
+		Data struct {
+			AclCache aclCacheContents
+		}
+	}
+	require.NoError(t, json.Unmarshal(b, &resp))
+	return resp.Data.AclCache
+}
+
+func (c aclCacheContents) hasRule(group string, r rule) bool {
+	for _, g := range c.Groups {
+		if g.Name != group {
+			continue
+		}
+		for _, gr := range g.Rules {
+			if gr == r {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func TestAclCacheRefresh(t *testing.T) {
+	accessJwt, _ := testutil.GrootHttpLogin(adminEndpoint)
+	// The ACL caches of alpha1 and alpha2 are refreshed independently.
+	alphas := []string{adminEndpoint, "http://localhost:8182/admin"}
+
+	createGroup(t, accessJwt, "cache-dev")
+	defer deleteGroup(t, accessJwt, "cache-dev")
+	newRule := rule{"cache-pred", Read.Code}
+	addRulesToGroup(t, accessJwt, "cache-dev", []rule{newRule})
+
+	// Until an alpha refreshes its cache, it doesn't have the rule, and its last refresh is
+	// older than the rule change.
+	changedAt := time.Now().Unix()
+	for _, alpha := range alphas {
+		cache := aclCacheOf(t, alpha, accessJwt)
+		require.NotZero(t, cache.LastRefresh)
+		if !cache.hasRule("cache-dev", newRule) {
+			require.True(t, cache.LastRefresh <= changedAt)
+		}
+	}
+
+	// Both alphas have the rule once their caches are refreshed, which happens every 5s.
+	for _, alpha := range alphas {
+		for i := 0; ; i++ {
+			cache := aclCacheOf(t, alpha, accessJwt)
+			if cache.hasRule("cache-dev", newRule) {
+				break
+			}
+			require.True(t, i < 20, "the ACL cache of %s doesn't have the new rule", alpha)
+			time.Sleep(time.Second)
+		}
+	}
+}
+
+func TestGcACL(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Second)
+	defer cancel()
+	dg, err := testutil.DgraphClientWithGroot(testutil.SockAddr)
+	require.NoError(t, err)
+	accessJwt, _ := testutil.GrootHttpLogin(adminEndpoint)
+
+	require.NoError(t, dg.Alter(ctx, &api.Operation{Schema: `
+		gc_kept: string .
+		gc_dropped: string .
+	`}))
+	for _, group := range []string{"gc-dev", "gc-empty"} {
+		deleteGroup(t, accessJwt, group)
+		createGroup(t, accessJwt, group)
+		defer deleteGroup(t, accessJwt, group)
+	}
+	addRulesToGroup(t, accessJwt, "gc-dev", []rule{{"gc_kept", Read.Code},
+		{"gc_dropped", Read.Code}})
+	addRulesToGroup(t, accessJwt, "gc-empty", []rule{{"gc_dropped", Write.Code}})
+	require.NoError(t, dg.Alter(ctx, &api.Operation{DropAttr: "gc_dropped"}))
+
+	b := makeRequest(t, accessJwt, testutil.GraphQLParams{
+		Query: `mutation {
+			gcACL(deleteEmptyGroups: true) {
+				response {
+					rules {
+						group
+						predicate
+					}
+					groups
+				}
+			}
+		}`,
+	})
+	var resp struct {
+		Data struct {
+			GcACL struct {
+				Response struct {
+					Rules []struct {
+						Group     string
+						Predicate string
+					}
+					Groups []string
+				}
+			}
+		}
+	}
+	require.NoError(t, json.Unmarshal(b, &resp))
+	removed := resp.Data.GcACL.Response
+	require.Contains(t, removed.Rules, struct {
+		Group     string
+		Predicate string
+	}{"gc-dev", "gc_dropped"})
+	require.Contains(t, removed.Groups, "gc-empty")
+	require.NotContains(t, removed.Groups, "gc-dev")
+
+	// The rule for the predicate that still exists survives.
+	b = makeRequest(t, accessJwt, testutil.GraphQLParams{
+		Query: `query {
+			gcDev: getGroup(name: "gc-dev") {
+				rules {
+					predicate
+				}
+			}
+			gcEmpty: getGroup(name: "gc-empty") {
+				name
+			}
+		}`,
+	})
+	testutil.CompareJSON(t, `{"data":{"gcDev":{"rules":[{"predicate":"gc_kept"}]},
+		"gcEmpty":null}}`, string(b))
+}
+
+func TestGroupDefaultPermission(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Second)
+	defer cancel()
+
+	dg, err := testutil.DgraphClientWithGroot(testutil.SockAddr)
+	require.NoError(t, err)
+	addDataAndRules(ctx, t, dg)
+
+	accessJwt, _, err := testutil.HttpLogin(&testutil.LoginParams{
+		Endpoint: adminEndpoint,
+		UserID:   "groot",
+		Passwd:   "password",
+	})
+	require.NoError(t, err, "login failed")
+
+	// Give the dev group of alice read access to the predicates it has no rule for.
+	resp := makeRequest(t, accessJwt, testutil.GraphQLParams{
+		Query: `mutation {
+			updateGroup(input: {filter: {name: {eq: "dev"}}, set: {defaultPermission: 4}}) {
+				group {
+					name
+					defaultPermission
+				}
+			}
+		}`,
+	})
+	testutil.CompareJSON(t, `{"data": {"updateGroup": {"group": [
+		{"name": "dev", "defaultPermission": 4}
+	]}}}`, string(resp))
+	time.Sleep(6 * time.Second)
+
+	userClient, err := testutil.DgraphClient(testutil.SockAddr)
+	require.NoError(t, err)
+	require.NoError(t, userClient.Login(ctx, userid, userpassword))
+
+	// There's no rule for <age>, so it's read through the default permission, while the write
+	// rule of dev for <nickname> replaces the default permission on it.
+	queryResp, err := userClient.NewTxn().Query(ctx, `{
+		me(func: has(age)) {
+			name
+			nickname
+			age
+		}
+	}`)
+	require.NoError(t, err)
+	testutil.CompareJSON(t, `{"me":[{"name":"RandomGuy2","age":"25"}]}`, string(queryResp.Json))
+}
@@ -14,6 +14,7 @@ package acl
 
 import (
 	"encoding/json"
+	"strings"
 
 	"github.com/dgraph-io/dgo/v2"
 	"github.com/dgraph-io/dgo/v2/protos/api"
@@ -23,6 +24,19 @@ import (
 	"github.com/spf13/viper"
 )
 
+// UserRulesPrefix is prepended to the name of a user to get the key that the ACL cache keeps the
+// rules set directly on the user under, in place of a group name.
+const UserRulesPrefix = "user:"
+
+// ValidateGroupName returns an error if name can't be the name of a group. Group names can't
+// start with UserRulesPrefix, so that a group can't be mistaken for the rules of a user.
+func ValidateGroupName(name string) error {
+	if strings.HasPrefix(name, UserRulesPrefix) {
+		return errors.Errorf("group name %q can't start with %q", name, UserRulesPrefix)
+	}
+	return nil
+}
+
 // GetGroupIDs returns a slice containing the group ids of all the given groups.
 func GetGroupIDs(groups []Group) []string {
 	if len(groups) == 0 {
@@ -74,6 +88,10 @@ type User struct {
 	Password      string  `json:"dgraph.password"`
 	PasswordMatch bool    `json:"password_match"`
 	Groups        []Group `json:"dgraph.user.group"`
+	Disabled      bool    `json:"dgraph.user.disabled"`
+	// TotpSecret is the secret of the TOTP codes that the user needs to log in with if MFA is
+	// enabled for it.
+	TotpSecret string `json:"dgraph.user.totp"`
 }
 
 // GetUid returns the UID of the user.
@@ -104,18 +122,24 @@ func UnmarshalUser(resp *api.Response, userKey string) (user *User, err error) {
 }
 
 // Acl represents the permissions in the ACL system.
-// An Acl can have a predicate and permission for that predicate.
+// An Acl can have a predicate and permission for that predicate. If Deny is set, the Acl denies
+// the permission instead of granting it. If Filter is set, the read permission it grants only
+// covers the nodes that match the filter.
 type Acl struct {
 	Predicate string `json:"dgraph.rule.predicate"`
 	Perm      int32  `json:"dgraph.rule.permission"`
+	Deny      bool   `json:"dgraph.rule.deny"`
+	Filter    string `json:"dgraph.rule.filter"`
 }
 
-// Group represents a group in the ACL system.
+// Group represents a group in the ACL system. DefaultPerm is the permission the group has on
+// the predicates it has no rule for, other than the reserved predicates.
 type Group struct {
-	Uid     string `json:"uid"`
-	GroupID string `json:"dgraph.xid"`
-	Users   []User `json:"~dgraph.user.group"`
-	Rules   []Acl  `json:"dgraph.acl.rule"`
+	Uid         string `json:"uid"`
+	GroupID     string `json:"dgraph.xid"`
+	Users       []User `json:"~dgraph.user.group"`
+	Rules       []Acl  `json:"dgraph.acl.rule"`
+	DefaultPerm int32  `json:"dgraph.group.default_permission"`
 }
 
 // GetUid returns the UID of the group.
@@ -537,6 +537,27 @@ func Parse(r Request) (Result, error) {
 	return ParseWithNeedVars(r, nil)
 }
 
+// ParseFilter parses filter, which is the body of a @filter directive such as
+// eq(name, "Alice") AND has(age), on its own.
+func ParseFilter(filter string) (*FilterTree, error) {
+	res, err := Parse(Request{
+		Str: fmt.Sprintf("{ q(func: uid(0x1)) @filter(%s) { uid } }", filter),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid filter %q", filter)
+	}
+	// The filter mustn't have closed the directive and added something else to the query.
+	if len(res.Query) != 1 {
+		return nil, errors.Errorf("invalid filter %q", filter)
+	}
+	q := res.Query[0]
+	if q.Filter == nil || len(q.Children) != 1 || q.Children[0].Attr != "uid" || q.Cascade ||
+		q.Normalize || q.Recurse || q.IsGroupby || q.Facets != nil {
+		return nil, errors.Errorf("invalid filter %q", filter)
+	}
+	return q.Filter, nil
+}
+
 // ParseWithNeedVars performs parsing of a query with given needVars.
 //
 // The needVars parameter is passed in the case of upsert block.
@@ -5195,3 +5195,17 @@ func TestFilterWithEmpty(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, gq.Query[0].Filter.Func.Args[0].Value, "")
 }
+
+func TestParseFilter(t *testing.T) {
+	filter, err := ParseFilter(`eq(department, "eng") AND has(salary)`)
+	require.NoError(t, err)
+	require.Equal(t, "and", filter.Op)
+	require.Len(t, filter.Child, 2)
+	require.Equal(t, "department", filter.Child[0].Func.Attr)
+	require.Equal(t, "salary", filter.Child[1].Func.Attr)
+
+	_, err = ParseFilter(`eq(department, "eng"`)
+	require.Error(t, err)
+	_, err = ParseFilter(`has(salary)) { uid } other(func: has(salary)) @filter(has(salary)`)
+	require.Error(t, err, "a filter shouldn't be able to add query blocks")
+}
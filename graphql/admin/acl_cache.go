@@ -0,0 +1,40 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package admin
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/dgraph-io/dgraph/edgraph"
+	"github.com/dgraph-io/dgraph/gql"
+	"github.com/dgraph-io/dgraph/graphql/schema"
+	"github.com/pkg/errors"
+)
+
+// aclCacheResolver resolves aclCache with the contents of the ACL cache of this alpha.
+type aclCacheResolver struct {
+}
+
+func (ar *aclCacheResolver) Rewrite(q schema.Query) (*gql.GraphQuery, error) {
+	return nil, nil
+}
+
+func (ar *aclCacheResolver) Query(ctx context.Context, query *gql.GraphQuery) ([]byte, error) {
+	b, err := json.Marshal(map[string]interface{}{"aclCache": edgraph.AclCache()})
+	return b, errors.Wrapf(err, "couldn't marshal the ACL cache")
+}
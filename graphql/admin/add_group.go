@@ -0,0 +1,218 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	dgoapi "github.com/dgraph-io/dgo/v2/protos/api"
+	"github.com/dgraph-io/dgraph/edgraph"
+	"github.com/dgraph-io/dgraph/gql"
+	"github.com/dgraph-io/dgraph/graphql/resolve"
+	"github.com/dgraph-io/dgraph/graphql/schema"
+	"github.com/pkg/errors"
+)
+
+const (
+	// newGroupNode is the blank node addGroupWithMembers creates the group as.
+	newGroupNode = "group"
+
+	// existingGroupVar is the variable the addGroupWithMembers upsert assigns a group that
+	// already has the name of the new group to.
+	existingGroupVar = "existingGroup"
+)
+
+// newGroupRewriter is the MutationRewriter of addGroupWithMembers. Its upsert creates a group,
+// so the group is found from the uids Dgraph assigned, not from the upsert query.
+type newGroupRewriter struct {
+	precomputedRewriter
+}
+
+func (nr *newGroupRewriter) FromMutationResult(
+	mutation schema.Mutation,
+	assigned map[string]string,
+	result map[string]interface{}) (*gql.GraphQuery, error) {
+
+	uid, ok := assigned[newGroupNode]
+	if !ok {
+		return nil, errors.Errorf("the group wasn't created, because a group with the same name " +
+			"was added or one of its users was deleted concurrently")
+	}
+
+	// Returning the new group as the result of a block named after the mutation lets the
+	// update rewriter build the query that returns it.
+	mutated := make(map[string]interface{}, len(result)+1)
+	for k, v := range result {
+		mutated[k] = v
+	}
+	mutated[mutation.ResponseName()] = []interface{}{map[string]interface{}{"uid": uid}}
+	return resolve.NewUpdateRewriter().FromMutationResult(mutation, assigned, mutated)
+}
+
+// existingUsers returns those of the users with the given names that exist.
+func existingUsers(ctx context.Context, names []string) (map[string]bool, error) {
+	namesJSON, err := json.Marshal(names)
+	if err != nil {
+		return nil, err
+	}
+	query := &gql.GraphQuery{
+		Attr: "users",
+		Func: &gql.Function{
+			Name: "eq",
+			Args: []gql.Arg{{Value: "dgraph.xid"}, {Value: string(namesJSON)}},
+		},
+		Filter: &gql.FilterTree{
+			Func: &gql.Function{
+				Name: "type",
+				Args: []gql.Arg{{Value: "User"}},
+			},
+		},
+		Children: []*gql.GraphQuery{{Attr: "dgraph.xid"}},
+	}
+
+	resp, err := resolve.AdminQueryExecutor().Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var res struct {
+		Users []aclUser `json:"users"`
+	}
+	if err := json.Unmarshal(resp, &res); err != nil {
+		return nil, errors.Wrapf(err, "couldn't unmarshal users")
+	}
+
+	found := make(map[string]bool)
+	for _, user := range res.Users {
+		found[user.Name] = true
+	}
+	return found, nil
+}
+
+// addGroupWithMembers resolves the addGroupWithMembers mutation, which creates a group with its
+// rules and makes the given users members of it, in a single transaction. The group must not
+// exist yet and all the users must exist, otherwise nothing is changed.
+func addGroupWithMembers(ctx context.Context, m schema.Mutation) (*resolve.Resolved, bool) {
+	input, _ := m.ArgValue(schema.InputArgName).(map[string]interface{})
+	name, _ := input["name"].(string)
+	rulesArg, _ := input["rules"].([]interface{})
+	usersArg, _ := input["users"].([]interface{})
+
+	if name == "" {
+		return failedMutation(m, errors.Errorf("name of the group can't be empty"))
+	}
+	if err := edgraph.ValidateGroupName(name); err != nil {
+		return failedMutation(m, err)
+	}
+	if err := validateRules(ctx, rulesArg); err != nil {
+		return failedMutation(m, err)
+	}
+	rules, err := rulesByPredicate(rulesArg)
+	if err != nil {
+		return failedMutation(m, err)
+	}
+
+	var users []string
+	seen := make(map[string]bool)
+	for _, u := range usersArg {
+		user, _ := u.(string)
+		if user, err = edgraph.ResolveUserId(ctx, user); err != nil {
+			return failedMutation(m, err)
+		}
+		if !seen[user] {
+			seen[user] = true
+			users = append(users, user)
+		}
+	}
+
+	existing, err := groupRules(ctx, name)
+	if err != nil {
+		return failedMutation(m, err)
+	}
+	if _, ok := existing[name]; ok {
+		return failedMutation(m, errors.Errorf("group %s already exists", name))
+	}
+	if len(users) > 0 {
+		found, err := existingUsers(ctx, users)
+		if err != nil {
+			return failedMutation(m, err)
+		}
+		var missing []string
+		for _, user := range users {
+			if !found[user] {
+				missing = append(missing, user)
+			}
+		}
+		if len(missing) > 0 {
+			sort.Strings(missing)
+			return failedMutation(m, errors.Errorf("users don't exist: %s",
+				strings.Join(missing, ", ")))
+		}
+	}
+
+	// The checks above are repeated by the condition of the upsert, so that the group isn't
+	// created if a group with the same name is added, or a user is deleted, in the meantime.
+	groupQuery := groupUpsertQuery(m, name).Children[0]
+	groupQuery.Var, groupQuery.Attr = existingGroupVar, "var"
+	query := &gql.GraphQuery{Children: []*gql.GraphQuery{groupQuery}}
+	conds := []string{fmt.Sprintf("eq(len(%s), 0)", existingGroupVar)}
+
+	ruleJSON := make([]interface{}, 0, len(rules))
+	for i, rule := range rules {
+		ruleJSON = append(ruleJSON, newRuleJSON(fmt.Sprintf("rule%d", i), rule))
+	}
+	set := []interface{}{map[string]interface{}{
+		"uid":             "_:" + newGroupNode,
+		"dgraph.xid":      name,
+		"dgraph.type":     "Group",
+		"dgraph.acl.rule": ruleJSON,
+	}}
+	for i, user := range users {
+		userVar := fmt.Sprintf("user%d", i)
+		userQuery := userUpsertQuery(m, user).Children[0]
+		userQuery.Var, userQuery.Attr = userVar, "var"
+		query.Children = append(query.Children, userQuery)
+		conds = append(conds, fmt.Sprintf("eq(len(%s), 1)", userVar))
+
+		set = append(set, map[string]interface{}{
+			"uid":               fmt.Sprintf("uid(%s)", userVar),
+			"dgraph.user.group": map[string]interface{}{"uid": "_:" + newGroupNode},
+		})
+	}
+
+	setJSON, err := json.Marshal(set)
+	if err != nil {
+		return failedMutation(m, err)
+	}
+	mutation := &dgoapi.Mutation{
+		SetJson: setJSON,
+		Cond:    fmt.Sprintf("@if(%s)", strings.Join(conds, " and ")),
+	}
+
+	return resolve.NewMutationResolver(
+		&newGroupRewriter{precomputedRewriter{
+			query:     query,
+			mutations: []*dgoapi.Mutation{mutation},
+		}},
+		resolve.DgraphAsQueryExecutor(),
+		resolve.DgraphAsMutationExecutor(),
+		resolve.StdMutationCompletion(m.Name())).Resolve(ctx, m)
+}
@@ -29,6 +29,8 @@ import (
 
 	badgerpb "github.com/dgraph-io/badger/v2/pb"
 	"github.com/dgraph-io/badger/v2/y"
+	"github.com/dgraph-io/dgraph/edgraph"
+	"github.com/dgraph-io/dgraph/gql"
 	"github.com/dgraph-io/dgraph/graphql/resolve"
 	"github.com/dgraph-io/dgraph/graphql/schema"
 	"github.com/dgraph-io/dgraph/graphql/web"
@@ -52,6 +54,8 @@ const (
 		id: ID!
 		schema: String!  @dgraph(type: "dgraph.graphql.schema")
 		generatedSchema: String!
+		"""time the schema was applied"""
+		updatedAt: DateTime @dgraph(pred: "dgraph.graphql.schema_updated_at")
 	}
 	  
 	"""Node state is the state of an individual node in the Dgraph cluster """
@@ -59,24 +63,100 @@ const (
 		"""node type : either 'alpha' or 'zero'"""
 		instance: String
 		address: String
-		"""node health status : either 'healthy' or 'unhealthy'"""
+		"""node health status : either 'healthy', 'unhealthy' or 'draining'"""
 		status: String
 		group: Int
 		version: String
 		uptime: Int
 		lastEcho: Int
+		"""whether the node is the leader of its group"""
+		leader: Boolean
+		"""state of ACL, only reported for the alpha that serves the request"""
+		acl: ACLStatus
+		"""
+		checksum of the GraphQL schema the alpha is serving, only reported for the alpha that
+		serves the request
+		"""
+		graphqlSchemaVersion: String
+		"""
+		time the GraphQL schema the alpha is serving was applied, only reported for the alpha
+		that serves the request
+		"""
+		graphqlSchemaUpdatedAt: DateTime
+		"""
+		message set with setMaintenanceMessage, only reported for the alpha that serves the
+		request
+		"""
+		maintenanceMessage: String
 	}
 
+	enum NodeStateOrderable {
+		instance
+		address
+		status
+		group
+		uptime
+		lastEcho
+	}
+
+	input NodeStateOrder {
+		asc: NodeStateOrderable
+		desc: NodeStateOrderable
+		then: NodeStateOrder
+	}
+
+	"""ACLStatus is the state of the ACL subsystem of an alpha"""
+	type ACLStatus {
+		enabled: Boolean
+		"""unix time of the last refresh of the ACL cache, or 0 if it hasn't been refreshed"""
+		lastCacheRefresh: Int
+		userCount: Int
+		groupCount: Int
+	}
+
+	"""ReservedPredicate is a predicate that Dgraph reserves for its own use"""
+	type ReservedPredicate {
+		name: String!
+		"""whether the schema of the predicate can be altered, or the predicate dropped"""
+		modifiable: Boolean!
+	}
+
+	scalar DateTime
+
 	directive @dgraph(type: String, pred: String) on OBJECT | INTERFACE | FIELD_DEFINITION
 	directive @id on FIELD_DEFINITION
 
 
+	"""SchemaDiff is the change to the Dgraph schema made by a GraphQL schema update"""
+	type SchemaDiff {
+		addedPredicates: [String]
+		removedPredicates: [String]
+		modifiedPredicates: [String]
+		addedTypes: [String]
+		removedTypes: [String]
+		modifiedTypes: [String]
+	}
+
 	type UpdateGQLSchemaPayload {
 		gqlSchema: GQLSchema
+		diff: SchemaDiff
 	}
 
 	input UpdateGQLSchemaInput {
 		set: GQLSchemaPatch!
+		"""apply the update even if it removes predicates or types, or changes their type"""
+		force: Boolean
+	}
+
+	input UpdateGQLAndDgraphSchemaInput {
+		set: GQLSchemaPatch!
+		"""
+		Dgraph schema, like the schema of an alter operation, that's applied along with the
+		schema generated for the GraphQL schema
+		"""
+		dgraphSchema: String!
+		"""apply the update even if it removes predicates or types, or changes their type"""
+		force: Boolean
 	}
 
 	input GQLSchemaPatch {
@@ -85,6 +165,8 @@ const (
 
 	input ExportInput {
 		format: String
+		"""directory to export to on the leader of each group, defaults to the --export directory"""
+		destination: String
 	}
 
 	type Response {
@@ -92,8 +174,49 @@ const (
 		message: String
 	}
 
+	type ExportResponse {
+		code: String
+		message: String
+		"""id of the task running the export, to be polled with the task query"""
+		taskId: ID
+	}
+
 	type ExportPayload {
-		response: Response
+		response: ExportResponse
+	}
+
+	input TransferLeadershipInput {
+		group: Int!
+		"""address of the alpha that's made the leader of the group"""
+		to: String!
+	}
+
+	type TransferLeadershipResponse {
+		code: String
+		message: String
+		"""address of the leader of the group once the transfer is done"""
+		leader: String
+	}
+
+	type TransferLeadershipPayload {
+		response: TransferLeadershipResponse
+	}
+
+	enum TaskStatus {
+		Queued
+		Running
+		Done
+		Failed
+	}
+
+	"""Task is the state of a long-running operation, like an export"""
+	type Task {
+		id: ID
+		kind: String
+		status: TaskStatus
+		"""percentage of the task that's done"""
+		progress: Float
+		error: String
 	}
 
 	input DrainingInput {
@@ -104,10 +227,18 @@ const (
 		response: Response
 	}
 
+	type SetMaintenanceMessagePayload {
+		response: Response
+	}
+
 	type ShutdownPayload {
 		response: Response
 	}
 
+	type InvalidateIntrospectionCachePayload {
+		response: Response
+	}
+
 	input ConfigInput {
 		lruMb: Float
 	}
@@ -116,20 +247,106 @@ const (
 		response: Response
 	}
 
+	"""DgraphPredicate is the schema of a predicate of the Dgraph schema"""
+	type DgraphPredicate {
+		predicate: String
+		type: String
+		index: Boolean
+		tokenizer: [String]
+		reverse: Boolean
+		count: Boolean
+		list: Boolean
+		upsert: Boolean
+		lang: Boolean
+	}
+
+	type DgraphTypeField {
+		name: String
+	}
+
+	"""DgraphType is a type of the Dgraph schema"""
+	type DgraphType {
+		name: String
+		fields: [DgraphTypeField]
+	}
+
+	"""DgraphSchema is the Dgraph schema the GraphQL schema is served from"""
+	type DgraphSchema {
+		schema: [DgraphPredicate]
+		types: [DgraphType]
+	}
+
+	type SchemaChecksum {
+		"""checksum of both the GraphQL and the Dgraph schema"""
+		checksum: String
+		"""checksum of the GraphQL schema, as reported by health"""
+		graphqlSchemaVersion: String
+		"""
+		checksum of the Dgraph schema, which doesn't depend on the order of its predicates and
+		types
+		"""
+		dgraphSchemaChecksum: String
+	}
+
+	"""ConfigEntry is a setting of the alpha, with the values of secrets redacted"""
+	type ConfigEntry {
+		key: String
+		value: String
+	}
+
 	` + adminTypes + `
 
 	type Query {
 		getGQLSchema: GQLSchema
-		health: [NodeState]
+		"""
+		health returns the state of every node in the cluster. The nodes can be limited to those
+		of a group, or with a status, and are returned in the order of the cluster membership
+		unless order is given.
+		"""
+		health(group: Int, status: String, order: NodeStateOrder,
+			first: Int, offset: Int): [NodeState]
+		reservedPredicates: [ReservedPredicate]
+		task(id: ID!): Task
+		config: [ConfigEntry]
+		"""
+		dgraphSchema returns the predicates and types of the Dgraph schema, as returned by a
+		schema {} query. Only guardians can read it.
+		"""
+		dgraphSchema: DgraphSchema
+		"""
+		schemaChecksum identifies the GraphQL schema served by the alpha together with the Dgraph
+		schema. Alphas serving the same schemas return the same checksum.
+		"""
+		schemaChecksum: SchemaChecksum
 
 		` + adminQueries + `
 	}
 
 	type Mutation {
 		updateGQLSchema(input: UpdateGQLSchemaInput!) : UpdateGQLSchemaPayload
+		"""
+		updates the GraphQL schema to the one at url, which must be on one of the hosts allowed
+		by --graphql_schema_url_hosts
+		"""
+		updateGQLSchemaFromURL(url: String!) : UpdateGQLSchemaPayload
+		"""
+		updates the GraphQL schema and the Dgraph schema together. The Dgraph schema is checked
+		before anything is saved, and if altering it fails, the previous GraphQL schema is
+		restored. This is best-effort rather than atomic: if the restore fails, the two schemas
+		can be left out of step.
+		"""
+		updateGQLAndDgraphSchema(input: UpdateGQLAndDgraphSchemaInput!) : UpdateGQLSchemaPayload
 		export(input: ExportInput!): ExportPayload
 		draining(input: DrainingInput!): DrainingPayload
+		"""
+		sets the message reported in the health of the alpha, and in the errors of the mutations
+		it rejects while draining, so that clients know why it's under maintenance. The message
+		is cleared if msg is null or empty.
+		"""
+		setMaintenanceMessage(msg: String): SetMaintenanceMessagePayload
 		shutdown: ShutdownPayload
+		transferLeadership(input: TransferLeadershipInput!): TransferLeadershipPayload
+		invalidateIntrospectionCache: InvalidateIntrospectionCachePayload
 		config(input: ConfigInput!): ConfigPayload
 
 		` + adminMutations + `
@@ -141,6 +358,7 @@ type gqlSchema struct {
 	ID              string `json:"id,omitempty"`
 	Schema          string `json:"schema,omitempty"`
 	GeneratedSchema string
+	UpdatedAt       string `json:"updatedAt,omitempty"`
 }
 
 type adminServer struct {
@@ -155,6 +373,9 @@ type adminServer struct {
 
 	schema gqlSchema
 
+	// The schema the main graphql endpoint (gqlServer) is serving.
+	servedSchema schema.Schema
+
 	// When the schema changes, we use these to create a new RequestResolver for
 	// the main graphql endpoint (gqlServer) and thus refresh the API.
 	fns               *resolve.ResolverFns
@@ -196,15 +417,13 @@ func newAdminResolver(
 		panic(err)
 	}
 
-	rf := newAdminResolverFactory()
-
 	server := &adminServer{
-		rf:                rf,
-		resolver:          resolve.New(adminSchema, rf),
 		gqlServer:         gqlServer,
 		fns:               fns,
 		withIntrospection: withIntrospection,
 	}
+	server.rf = newAdminResolverFactory(server)
+	server.resolver = resolve.New(adminSchema, server.rf)
 
 	prefix := x.DataKey("dgraph.graphql.schema", 0)
 	// Remove uid from the key, to get the correct prefix
@@ -242,6 +461,12 @@ func newAdminResolver(
 			ID:     fmt.Sprintf("%#x", pk.Uid),
 			Schema: string(pl.Postings[0].Value),
 		}
+		// The time the schema was updated at is stored in another predicate, which is read
+		// separately.
+		newSchema.UpdatedAt, err = schemaUpdatedAt(pk.Uid)
+		if err != nil {
+			glog.Errorf("Unable to read the time the GraphQL schema was updated at: %s", err)
+		}
 
 		schHandler, err := schema.NewHandler(newSchema.Schema)
 		if err != nil {
@@ -256,11 +481,18 @@ func newAdminResolver(
 			return
 		}
 
-		glog.Infof("Successfully updated GraphQL schema.")
-
 		server.mux.Lock()
 		defer server.mux.Unlock()
 
+		// The alpha that applied the update has already rebuilt its API, and rebuilding it
+		// again would only throw away its cached introspection results.
+		if newSchema.Schema == server.schema.Schema {
+			server.schema.ID, server.schema.UpdatedAt = newSchema.ID, newSchema.UpdatedAt
+			return
+		}
+
+		glog.Infof("Successfully updated GraphQL schema.")
+
 		server.schema = newSchema
 		server.resetSchema(gqlSchema)
 	}, 1, closer)
@@ -270,51 +502,116 @@ func newAdminResolver(
 	return server.resolver
 }
 
-func newAdminResolverFactory() resolve.ResolverFactory {
-	rf := resolverFactoryWithErrorMsg(errResolverNotFound).
+func newAdminResolverFactory(as *adminServer) resolve.ResolverFactory {
+	rf := (&readOnlyResolverFactory{resolverFactoryWithErrorMsg(errResolverNotFound)}).
 		WithQueryResolver("health",
 			func(q schema.Query) resolve.QueryResolver {
-				health := &healthResolver{}
+				health := &healthResolver{admin: as}
 
 				return resolve.NewQueryResolver(
 					health,
 					health,
 					resolve.AliasQueryCompletion())
 			}).
+		WithQueryResolver("reservedPredicates",
+			func(q schema.Query) resolve.QueryResolver {
+				reserved := &reservedPredicatesResolver{}
+
+				return resolve.NewQueryResolver(
+					reserved,
+					reserved,
+					resolve.AliasQueryCompletion())
+			}).
 		WithMutationResolver("updateGQLSchema", func(m schema.Mutation) resolve.MutationResolver {
 			return resolve.MutationResolverFunc(
 				func(ctx context.Context, m schema.Mutation) (*resolve.Resolved, bool) {
 					return &resolve.Resolved{Err: errors.Errorf(errMsgServerNotReady)}, false
 				})
 		}).
+		WithMutationResolver("updateGQLSchemaFromURL",
+			func(m schema.Mutation) resolve.MutationResolver {
+				return resolve.MutationResolverFunc(
+					func(ctx context.Context, m schema.Mutation) (*resolve.Resolved, bool) {
+						return &resolve.Resolved{Err: errors.Errorf(errMsgServerNotReady)}, false
+					})
+			}).
+		WithMutationResolver("updateGQLAndDgraphSchema",
+			func(m schema.Mutation) resolve.MutationResolver {
+				return resolve.MutationResolverFunc(
+					func(ctx context.Context, m schema.Mutation) (*resolve.Resolved, bool) {
+						return &resolve.Resolved{Err: errors.Errorf(errMsgServerNotReady)}, false
+					})
+			}).
 		WithQueryResolver("getGQLSchema", func(q schema.Query) resolve.QueryResolver {
 			return resolve.QueryResolverFunc(
 				func(ctx context.Context, query schema.Query) *resolve.Resolved {
 					return &resolve.Resolved{Err: errors.Errorf(errMsgServerNotReady)}
 				})
 		}).
+		WithQueryResolver("task",
+			func(q schema.Query) resolve.QueryResolver {
+				task := &taskResolver{}
+
+				return guardianOnlyQuery(resolve.NewQueryResolver(
+					task,
+					task,
+					resolve.AliasQueryCompletion()))
+			}).
+		WithQueryResolver("config",
+			func(q schema.Query) resolve.QueryResolver {
+				config := &serverConfigResolver{}
+
+				return guardianOnlyQuery(resolve.NewQueryResolver(
+					config,
+					config,
+					resolve.AliasQueryCompletion()))
+			}).
 		WithMutationResolver("export", func(m schema.Mutation) resolve.MutationResolver {
 			export := &exportResolver{}
 
 			// export implements the mutation rewriter, executor and query executor hence its passed
 			// thrice here.
-			return resolve.NewMutationResolver(
+			return guardianOnlyMutation(resolve.NewMutationResolver(
 				export,
 				export,
 				export,
-				resolve.StdMutationCompletion(m.ResponseName()))
+				resolve.StdMutationCompletion(m.ResponseName())))
 		}).
 		WithMutationResolver("draining", func(m schema.Mutation) resolve.MutationResolver {
 			draining := &drainingResolver{}
 
 			// draining implements the mutation rewriter, executor and query executor hence its
 			// passed thrice here.
-			return resolve.NewMutationResolver(
+			return guardianOnlyMutation(resolve.NewMutationResolver(
 				draining,
 				draining,
 				draining,
-				resolve.StdMutationCompletion(m.ResponseName()))
+				resolve.StdMutationCompletion(m.ResponseName())))
 		}).
+		WithMutationResolver("setMaintenanceMessage",
+			func(m schema.Mutation) resolve.MutationResolver {
+				maintenance := &maintenanceMessageResolver{}
+
+				// setMaintenanceMessage implements the mutation rewriter, executor and query
+				// executor, like draining.
+				return guardianOnlyMutation(resolve.NewMutationResolver(
+					maintenance,
+					maintenance,
+					maintenance,
+					resolve.StdMutationCompletion(m.ResponseName())))
+			}).
+		WithMutationResolver("transferLeadership",
+			func(m schema.Mutation) resolve.MutationResolver {
+				transfer := &transferLeadershipResolver{}
+
+				// transferLeadership implements the mutation rewriter, executor and query
+				// executor, like draining.
+				return guardianOnlyMutation(resolve.NewMutationResolver(
+					transfer,
+					transfer,
+					transfer,
+					resolve.StdMutationCompletion(m.ResponseName())))
+			}).
 		WithMutationResolver("shutdown", func(m schema.Mutation) resolve.MutationResolver {
 			shutdown := &shutdownResolver{}
 
@@ -430,6 +727,7 @@ func (as *adminServer) addConnectedAdminResolvers() {
 		func(m schema.Mutation) resolve.MutationResolver {
 			updResolver := &updateSchemaResolver{
 				admin:                as,
+				input:                getSchemaInput,
 				baseAddRewriter:      addRw,
 				baseMutationRewriter: updRw,
 				baseMutationExecutor: mutExec,
@@ -439,8 +737,53 @@ func (as *adminServer) addConnectedAdminResolvers() {
 				updResolver,
 				updResolver,
 				updResolver,
-				resolve.StdMutationCompletion(m.Name()))
+				updResolver.withDiff(resolve.StdMutationCompletion(m.Name())))
 		}).
+		WithMutationResolver("updateGQLSchemaFromURL",
+			func(m schema.Mutation) resolve.MutationResolver {
+				// The schema at the URL is applied just like one given to updateGQLSchema.
+				updResolver := &updateSchemaResolver{
+					admin:                as,
+					input:                schemaFromURL,
+					baseAddRewriter:      addRw,
+					baseMutationRewriter: updRw,
+					baseMutationExecutor: mutExec,
+				}
+
+				return guardianOnlyMutation(resolve.NewMutationResolver(
+					updResolver,
+					updResolver,
+					updResolver,
+					updResolver.withDiff(resolve.StdMutationCompletion(m.Name()))))
+			}).
+		WithMutationResolver("updateGQLAndDgraphSchema",
+			func(m schema.Mutation) resolve.MutationResolver {
+				updResolver := &updateSchemaResolver{
+					admin:                as,
+					input:                getSchemaInput,
+					restoreOnFailure:     true,
+					baseAddRewriter:      addRw,
+					baseMutationRewriter: updRw,
+					baseMutationExecutor: mutExec,
+				}
+
+				return guardianOnlyMutation(resolve.NewMutationResolver(
+					updResolver,
+					updResolver,
+					updResolver,
+					updResolver.withDiff(resolve.StdMutationCompletion(m.Name()))))
+			}).
+		WithMutationResolver("invalidateIntrospectionCache",
+			func(m schema.Mutation) resolve.MutationResolver {
+				invalidate := &invalidateIntrospectionResolver{admin: as}
+				// invalidateIntrospectionCache implements the mutation rewriter, executor and
+				// query executor, like shutdown.
+				return guardianOnlyMutation(resolve.NewMutationResolver(
+					invalidate,
+					invalidate,
+					invalidate,
+					resolve.StdMutationCompletion(m.ResponseName())))
+			}).
 		WithQueryResolver("getGQLSchema",
 			func(q schema.Query) resolve.QueryResolver {
 				getResolver := &getSchemaResolver{
@@ -454,6 +797,26 @@ func (as *adminServer) addConnectedAdminResolvers() {
 					getResolver,
 					resolve.StdQueryCompletion())
 			}).
+		WithQueryResolver("schemaChecksum",
+			func(q schema.Query) resolve.QueryResolver {
+				checksum := &schemaChecksumResolver{admin: as}
+
+				// The checksum is of the whole Dgraph schema, which only guardians can read.
+				return guardianOnlyQuery(resolve.NewQueryResolver(
+					checksum,
+					checksum,
+					resolve.AliasQueryCompletion()))
+			}).
+		WithQueryResolver("dgraphSchema",
+			func(q schema.Query) resolve.QueryResolver {
+				dgraphSchema := &dgraphSchemaResolver{}
+
+				// The whole Dgraph schema is returned, which only guardians can read.
+				return guardianOnlyQuery(resolve.NewQueryResolver(
+					dgraphSchema,
+					dgraphSchema,
+					resolve.AliasQueryCompletion()))
+			}).
 		WithQueryResolver("queryGroup",
 			func(q schema.Query) resolve.QueryResolver {
 				return resolve.NewQueryResolver(
@@ -464,10 +827,105 @@ func (as *adminServer) addConnectedAdminResolvers() {
 		WithQueryResolver("queryUser",
 			func(q schema.Query) resolve.QueryResolver {
 				return resolve.NewQueryResolver(
-					qryRw,
+					&userGroupRewriter{},
+					qryExec,
+					resolve.StdQueryCompletion())
+			}).
+		WithQueryResolver("queryUserPage",
+			func(q schema.Query) resolve.QueryResolver {
+				page := &userPageResolver{}
+
+				return resolve.NewQueryResolver(
+					page,
+					page,
+					resolve.StdQueryCompletion())
+			}).
+		WithQueryResolver("aggregateUser",
+			func(q schema.Query) resolve.QueryResolver {
+				return resolve.NewQueryResolver(
+					&aggregateRewriter{typ: "User"},
+					qryExec,
+					resolve.StdQueryCompletion())
+			}).
+		WithQueryResolver("aggregateGroup",
+			func(q schema.Query) resolve.QueryResolver {
+				return resolve.NewQueryResolver(
+					&aggregateRewriter{typ: "Group"},
 					qryExec,
 					resolve.StdQueryCompletion())
 			}).
+		WithQueryResolver("effectivePermission",
+			func(q schema.Query) resolve.QueryResolver {
+				effPerm := &effectivePermissionResolver{}
+
+				return aclReaderQuery(resolveUserNameQuery(resolve.NewQueryResolver(
+					effPerm,
+					effPerm,
+					resolve.AliasQueryCompletion()), "user"))
+			}).
+		WithQueryResolver("readablePredicates",
+			func(q schema.Query) resolve.QueryResolver {
+				readable := &readablePredicatesResolver{}
+
+				return aclReaderQuery(resolveUserNameQuery(resolve.NewQueryResolver(
+					readable,
+					readable,
+					resolve.AliasQueryCompletion()), "user"))
+			}).
+		WithQueryResolver("groupsWithAccessTo",
+			func(q schema.Query) resolve.QueryResolver {
+				groups := &groupsWithAccessResolver{}
+
+				return aclReaderQuery(resolve.NewQueryResolver(
+					groups,
+					groups,
+					resolve.AliasQueryCompletion()))
+			}).
+		WithQueryResolver("evaluateAccess",
+			func(q schema.Query) resolve.QueryResolver {
+				evaluate := &evaluateAccessResolver{}
+
+				return aclReaderQuery(resolve.NewQueryResolver(
+					evaluate,
+					evaluate,
+					resolve.AliasQueryCompletion()))
+			}).
+		WithQueryResolver("isPredicateProtected",
+			func(q schema.Query) resolve.QueryResolver {
+				protected := &protectedPredicateResolver{}
+
+				return aclReaderQuery(resolve.NewQueryResolver(
+					protected,
+					protected,
+					resolve.AliasQueryCompletion()))
+			}).
+		WithQueryResolver("validateGroup",
+			func(q schema.Query) resolve.QueryResolver {
+				validate := &validateGroupResolver{}
+
+				return aclReaderQuery(resolve.NewQueryResolver(
+					validate,
+					validate,
+					resolve.AliasQueryCompletion()))
+			}).
+		WithQueryResolver("queryACLAudit",
+			func(q schema.Query) resolve.QueryResolver {
+				audit := &aclAuditResolver{}
+
+				return aclReaderQuery(resolve.NewQueryResolver(
+					audit,
+					audit,
+					resolve.AliasQueryCompletion()))
+			}).
+		WithQueryResolver("aclCache",
+			func(q schema.Query) resolve.QueryResolver {
+				cache := &aclCacheResolver{}
+
+				return aclReaderQuery(resolve.NewQueryResolver(
+					cache,
+					cache,
+					resolve.AliasQueryCompletion()))
+			}).
 		WithQueryResolver("getGroup",
 			func(q schema.Query) resolve.QueryResolver {
 				return resolve.NewQueryResolver(
@@ -484,57 +942,216 @@ func (as *adminServer) addConnectedAdminResolvers() {
 			}).
 		WithMutationResolver("addUser",
 			func(m schema.Mutation) resolve.MutationResolver {
-				return resolve.NewMutationResolver(
+				return auditedMutation(normalizeNewUserNames(resolve.NewMutationResolver(
 					resolve.NewAddRewriter(),
 					resolve.DgraphAsQueryExecutor(),
 					resolve.DgraphAsMutationExecutor(),
-					resolve.StdMutationCompletion(m.Name()))
+					resolve.StdMutationCompletion(m.Name()))))
 			}).
 		WithMutationResolver("addGroup",
 			func(m schema.Mutation) resolve.MutationResolver {
-				return resolve.NewMutationResolver(
+				return auditedMutation(validateGroupRules(resolve.NewMutationResolver(
 					resolve.NewAddRewriter(),
 					resolve.DgraphAsQueryExecutor(),
 					resolve.DgraphAsMutationExecutor(),
-					resolve.StdMutationCompletion(m.Name()))
+					resolve.StdMutationCompletion(m.Name()))))
+			}).
+		WithMutationResolver("addGroupWithMembers",
+			func(m schema.Mutation) resolve.MutationResolver {
+				return auditedMutation(guardianOnlyMutation(
+					resolve.MutationResolverFunc(addGroupWithMembers)))
 			}).
 		WithMutationResolver("updateUser",
 			func(m schema.Mutation) resolve.MutationResolver {
-				return resolve.NewMutationResolver(
+				return auditedMutation(protectGuardians(resolve.NewMutationResolver(
 					resolve.NewUpdateRewriter(),
 					resolve.DgraphAsQueryExecutor(),
 					resolve.DgraphAsMutationExecutor(),
-					resolve.StdMutationCompletion(m.Name()))
+					resolve.StdMutationCompletion(m.Name()))))
 			}).
 		WithMutationResolver("updateGroup",
 			func(m schema.Mutation) resolve.MutationResolver {
-				return resolve.NewMutationResolver(
+				return auditedMutation(validateGroupRules(resolve.NewMutationResolver(
 					resolve.NewUpdateRewriter(),
 					resolve.DgraphAsQueryExecutor(),
 					resolve.DgraphAsMutationExecutor(),
-					resolve.StdMutationCompletion(m.Name()))
+					resolve.StdMutationCompletion(m.Name()))))
 			}).
 		WithMutationResolver("deleteUser",
 			func(m schema.Mutation) resolve.MutationResolver {
-				return resolve.NewMutationResolver(
+				return auditedMutation(protectGuardians(resolve.NewMutationResolver(
 					resolve.NewDeleteRewriter(),
 					resolve.NoOpQueryExecution(),
 					resolve.DgraphAsMutationExecutor(),
-					resolve.StdDeleteCompletion(m.Name()))
+					resolve.StdDeleteCompletion(m.Name()))))
 			}).
 		WithMutationResolver("deleteGroup",
 			func(m schema.Mutation) resolve.MutationResolver {
-				return resolve.NewMutationResolver(
+				return auditedMutation(protectReservedGroups(resolve.NewMutationResolver(
 					resolve.NewDeleteRewriter(),
 					resolve.NoOpQueryExecution(),
 					resolve.DgraphAsMutationExecutor(),
-					resolve.StdDeleteCompletion(m.Name()))
+					resolve.StdDeleteCompletion(m.Name()))))
+			}).
+		WithMutationResolver("removeUserFromAllGroups",
+			func(m schema.Mutation) resolve.MutationResolver {
+				// The name is resolved first, so that the guardians are protected from
+				// the user that's actually changed.
+				return auditedMutation(guardianOnlyMutation(resolveUserNames(protectGuardians(
+					resolve.NewMutationResolver(
+						&removeUserGroupsRewriter{},
+						resolve.DgraphAsQueryExecutor(),
+						resolve.DgraphAsMutationExecutor(),
+						resolve.StdMutationCompletion(m.Name()))), "name")))
+			}).
+		WithMutationResolver("copyUserGroups",
+			func(m schema.Mutation) resolve.MutationResolver {
+				return auditedMutation(guardianOnlyMutation(
+					resolve.MutationResolverFunc(copyUserGroups)))
+			}).
+		WithMutationResolver("setUserEnabled",
+			func(m schema.Mutation) resolve.MutationResolver {
+				// The name is resolved first, so that the guardians are protected from
+				// the user that's actually changed.
+				return auditedMutation(guardianOnlyMutation(resolveUserNames(protectGuardians(
+					resolve.NewMutationResolver(
+						&setUserEnabledRewriter{},
+						resolve.DgraphAsQueryExecutor(),
+						resolve.DgraphAsMutationExecutor(),
+						resolve.StdMutationCompletion(m.Name()))), "name")))
+			}).
+		WithMutationResolver("updateUserPassword",
+			func(m schema.Mutation) resolve.MutationResolver {
+				return auditedMutation(guardianOnlyMutation(resolveUserNames(
+					resolve.NewMutationResolver(
+						&updatePasswordRewriter{},
+						resolve.DgraphAsQueryExecutor(),
+						resolve.DgraphAsMutationExecutor(),
+						resolve.StdMutationCompletion(m.Name())), "name")))
+			}).
+		WithMutationResolver("enableMFA",
+			func(m schema.Mutation) resolve.MutationResolver {
+				enable := &enableMFAResolver{}
+				// enableMFA implements the mutation rewriter, executor and query executor,
+				// like shutdown.
+				return auditedMutation(guardianOnlyMutation(resolve.NewMutationResolver(
+					enable,
+					enable,
+					enable,
+					resolve.StdMutationCompletion(m.ResponseName()))))
+			}).
+		WithMutationResolver("resetMFA",
+			func(m schema.Mutation) resolve.MutationResolver {
+				return auditedMutation(guardianOnlyMutation(resolveUserNames(
+					resolve.NewMutationResolver(
+						&resetMFARewriter{},
+						resolve.DgraphAsQueryExecutor(),
+						resolve.DgraphAsMutationExecutor(),
+						resolve.StdMutationCompletion(m.Name())), "name")))
+			}).
+		WithMutationResolver("impersonate",
+			func(m schema.Mutation) resolve.MutationResolver {
+				return auditedMutation(resolve.MutationResolverFunc(
+					func(ctx context.Context, m schema.Mutation) (*resolve.Resolved, bool) {
+						// impersonate implements the mutation rewriter, executor and query
+						// executor, like login.
+						impersonate := &impersonateResolver{ctx: ctx}
+						return resolve.NewMutationResolver(
+							impersonate,
+							impersonate,
+							impersonate,
+							resolve.StdQueryCompletion()).Resolve(ctx, m)
+					}))
+			}).
+		WithMutationResolver("copyGroupRules",
+			func(m schema.Mutation) resolve.MutationResolver {
+				return auditedMutation(guardianOnlyMutation(
+					resolve.MutationResolverFunc(copyGroupRules)))
+			}).
+		WithMutationResolver("setGroupRules",
+			func(m schema.Mutation) resolve.MutationResolver {
+				return auditedMutation(guardianOnlyMutation(
+					resolve.MutationResolverFunc(setGroupRules)))
+			}).
+		WithMutationResolver("addRulesToGroups",
+			func(m schema.Mutation) resolve.MutationResolver {
+				return auditedMutation(guardianOnlyMutation(
+					resolve.MutationResolverFunc(addRulesToGroups)))
+			}).
+		WithMutationResolver("setUserRules",
+			func(m schema.Mutation) resolve.MutationResolver {
+				return auditedMutation(guardianOnlyMutation(
+					resolve.MutationResolverFunc(setUserRules)))
+			}).
+		WithMutationResolver("gcACL",
+			func(m schema.Mutation) resolve.MutationResolver {
+				gc := &gcACLResolver{}
+				// gcACL implements the mutation rewriter, executor and query executor, like
+				// shutdown.
+				return auditedMutation(guardianOnlyMutation(resolve.NewMutationResolver(
+					gc,
+					gc,
+					gc,
+					resolve.StdMutationCompletion(m.ResponseName()))))
+			}).
+		WithMutationResolver("rotateACLSecret",
+			func(m schema.Mutation) resolve.MutationResolver {
+				rotate := &rotateSecretResolver{}
+				// rotateACLSecret implements the mutation rewriter, executor and query
+				// executor, like shutdown.
+				return auditedMutation(guardianOnlyMutation(resolve.NewMutationResolver(
+					rotate,
+					rotate,
+					rotate,
+					resolve.StdMutationCompletion(m.ResponseName()))))
 			})
 }
 
+// guardianOnlyMutation wraps mr so that the mutation is only resolved for members of the
+// guardians group.
+func guardianOnlyMutation(mr resolve.MutationResolver) resolve.MutationResolver {
+	return resolve.MutationResolverFunc(
+		func(ctx context.Context, m schema.Mutation) (*resolve.Resolved, bool) {
+			if err := edgraph.AuthorizeGuardians(ctx); err != nil {
+				return &resolve.Resolved{
+					Err: schema.GQLWrapLocationf(err, m.Location(), "%s failed", m.Name()),
+				}, false
+			}
+			return mr.Resolve(ctx, m)
+		})
+}
+
+// guardianOnlyQuery wraps qr so that the query is only resolved for members of the guardians
+// group.
+func guardianOnlyQuery(qr resolve.QueryResolver) resolve.QueryResolver {
+	return resolve.QueryResolverFunc(
+		func(ctx context.Context, q schema.Query) *resolve.Resolved {
+			if err := edgraph.AuthorizeGuardians(ctx); err != nil {
+				return &resolve.Resolved{
+					Err: schema.GQLWrapLocationf(err, q.Location(), "%s failed", q.Name()),
+				}
+			}
+			return qr.Resolve(ctx, q)
+		})
+}
+
+// aclReaderQuery wraps qr so that the query is only resolved for members of the guardians or
+// readonly guardians groups.
+func aclReaderQuery(qr resolve.QueryResolver) resolve.QueryResolver {
+	return resolve.QueryResolverFunc(
+		func(ctx context.Context, q schema.Query) *resolve.Resolved {
+			if err := edgraph.AuthorizeAclReaders(ctx); err != nil {
+				return &resolve.Resolved{
+					Err: schema.GQLWrapLocationf(err, q.Location(), "%s failed", q.Name()),
+				}
+			}
+			return qr.Resolve(ctx, q)
+		})
+}
+
 func getCurrentGraphQLSchema(r *resolve.RequestResolver) (*gqlSchema, error) {
 	req := &schema.Request{
-		Query: `query { getGQLSchema { id schema } }`}
+		Query: `query { getGQLSchema { id schema updatedAt } }`}
 	resp := r.Resolve(context.Background(), req)
 	if len(resp.Errors) > 0 || resp.Data.Len() == 0 {
 		return nil, resp.Errors
@@ -549,6 +1166,31 @@ func getCurrentGraphQLSchema(r *resolve.RequestResolver) (*gqlSchema, error) {
 	return result.GetGQLSchema, err
 }
 
+// schemaUpdatedAt returns the time the GraphQL schema stored at uid was updated at.
+func schemaUpdatedAt(uid uint64) (string, error) {
+	resp, err := resolve.AdminQueryExecutor().Query(context.Background(), &gql.GraphQuery{
+		Attr:     "schema",
+		Func:     &gql.Function{Name: "uid", UID: []uint64{uid}},
+		Children: []*gql.GraphQuery{{Attr: "dgraph.graphql.schema_updated_at"}},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var result struct {
+		Schema []struct {
+			UpdatedAt string `json:"dgraph.graphql.schema_updated_at"`
+		}
+	}
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return "", errors.Wrapf(err, "couldn't unmarshal the GraphQL schema")
+	}
+	if len(result.Schema) == 0 {
+		return "", nil
+	}
+	return result.Schema[0].UpdatedAt, nil
+}
+
 func resolverFactoryWithErrorMsg(msg string) resolve.ResolverFactory {
 	errFunc := func(name string) error { return errors.Errorf(msg, name) }
 	qErr :=
@@ -572,6 +1214,7 @@ func (as *adminServer) resetSchema(gqlSchema schema.Schema) {
 		resolverFactory.WithSchemaIntrospection()
 	}
 
+	as.servedSchema = gqlSchema
 	as.gqlServer.ServeGQL(resolve.New(gqlSchema, resolverFactory))
 }
 
@@ -0,0 +1,50 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package admin
+
+import (
+	"github.com/dgraph-io/dgraph/gql"
+	"github.com/dgraph-io/dgraph/graphql/schema"
+)
+
+// aggregateRewriter rewrites aggregateUser and aggregateGroup into a Dgraph query that counts
+// the nodes of the Dgraph type typ.
+type aggregateRewriter struct {
+	typ string
+}
+
+func (ar *aggregateRewriter) Rewrite(q schema.Query) (*gql.GraphQuery, error) {
+	dgQuery := &gql.GraphQuery{
+		Attr: q.ResponseName(),
+		Func: &gql.Function{
+			Name: "type",
+			Args: []gql.Arg{{Value: ar.typ}},
+		},
+	}
+
+	for _, f := range q.SelectionSet() {
+		if f.Name() != "count" {
+			continue
+		}
+		dgQuery.Children = append(dgQuery.Children, &gql.GraphQuery{
+			Alias: f.ResponseName(),
+			Attr:  "count(uid)",
+		})
+	}
+
+	return dgQuery, nil
+}
@@ -0,0 +1,183 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package admin
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/dgraph-io/dgraph/edgraph"
+	"github.com/dgraph-io/dgraph/gql"
+	"github.com/dgraph-io/dgraph/graphql/resolve"
+	"github.com/dgraph-io/dgraph/graphql/schema"
+	"github.com/dgraph-io/dgraph/worker"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+)
+
+// aclAuditEntry records a single change made to the ACL data through the admin API.
+type aclAuditEntry struct {
+	Timestamp time.Time `json:"timestamp"`
+	// Actor is the user id from the access JWT of the request that made the change.
+	Actor string `json:"actor"`
+	// Action is the name of the mutation that made the change.
+	Action string `json:"action"`
+	// Target is the JSON encoded argument of the mutation, which identifies what was changed.
+	Target string `json:"target"`
+}
+
+// aclAuditLog is an append-only log of aclAuditEntry, stored as one JSON object per line in
+// worker.Config.AclAuditFile.
+type aclAuditLog struct {
+	sync.Mutex
+}
+
+var aclAudit aclAuditLog
+
+func (al *aclAuditLog) record(entry *aclAuditEntry) error {
+	if worker.Config.AclAuditFile == "" {
+		return nil
+	}
+
+	b, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	al.Lock()
+	defer al.Unlock()
+
+	f, err := os.OpenFile(worker.Config.AclAuditFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(append(b, '\n')); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// entries returns the entries recorded in the time range [since, until]. A zero since or until
+// leaves that end of the range open.
+func (al *aclAuditLog) entries(since, until time.Time) ([]*aclAuditEntry, error) {
+	entries := []*aclAuditEntry{}
+	if worker.Config.AclAuditFile == "" {
+		return entries, nil
+	}
+
+	al.Lock()
+	defer al.Unlock()
+
+	f, err := os.Open(worker.Config.AclAuditFile)
+	switch {
+	case os.IsNotExist(err):
+		return entries, nil
+	case err != nil:
+		return nil, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		entry := &aclAuditEntry{}
+		if err := json.Unmarshal(scanner.Bytes(), entry); err != nil {
+			return nil, errors.Wrapf(err, "couldn't read ACL audit file")
+		}
+		if (!since.IsZero() && entry.Timestamp.Before(since)) ||
+			(!until.IsZero() && entry.Timestamp.After(until)) {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	return entries, scanner.Err()
+}
+
+// auditTargetArgs are the arguments of the audited mutations that identify what they change. The
+// first of them that's set on a mutation is recorded as its target.
+var auditTargetArgs = []string{schema.InputArgName, "filter", "name", "names", "to", "user"}
+
+// auditedMutation wraps mr so that every successful resolution of the mutation is recorded in
+// the ACL audit log.
+func auditedMutation(mr resolve.MutationResolver) resolve.MutationResolver {
+	return resolve.MutationResolverFunc(
+		func(ctx context.Context, m schema.Mutation) (*resolve.Resolved, bool) {
+			resolved, success := mr.Resolve(ctx, m)
+			if !success {
+				return resolved, success
+			}
+
+			actor, err := edgraph.UserIdFromContext(ctx)
+			if err != nil {
+				glog.Errorf("Unable to find the user making ACL change %s: %v", m.Name(), err)
+			}
+
+			var target interface{}
+			for _, arg := range auditTargetArgs {
+				if target = m.ArgValue(arg); target != nil {
+					break
+				}
+			}
+			b, err := json.Marshal(target)
+			if err != nil {
+				glog.Errorf("Unable to marshal the target of ACL change %s: %v", m.Name(), err)
+			}
+
+			if err := aclAudit.record(&aclAuditEntry{
+				Timestamp: time.Now().UTC(),
+				Actor:     actor,
+				Action:    m.Name(),
+				Target:    string(b),
+			}); err != nil {
+				glog.Errorf("Unable to record ACL change %s in audit log: %v", m.Name(), err)
+			}
+			return resolved, success
+		})
+}
+
+// aclAuditResolver resolves queryACLAudit by reading the entries from the ACL audit log.
+type aclAuditResolver struct {
+	since, until time.Time
+}
+
+func (ar *aclAuditResolver) Rewrite(q schema.Query) (*gql.GraphQuery, error) {
+	for arg, t := range map[string]*time.Time{"since": &ar.since, "until": &ar.until} {
+		val, ok := q.ArgValue(arg).(string)
+		if !ok {
+			continue
+		}
+		var err error
+		if *t, err = time.Parse(time.RFC3339, val); err != nil {
+			return nil, errors.Wrapf(err, "%s must be an RFC 3339 timestamp", arg)
+		}
+	}
+	return nil, nil
+}
+
+func (ar *aclAuditResolver) Query(ctx context.Context, query *gql.GraphQuery) ([]byte, error) {
+	entries, err := aclAudit.entries(ar.since, ar.until)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := json.Marshal(map[string]interface{}{"queryACLAudit": entries})
+	return b, errors.Wrapf(err, "couldn't marshal ACL audit entries")
+}
@@ -0,0 +1,94 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package admin
+
+import (
+	"context"
+	"encoding/json"
+
+	dgoapi "github.com/dgraph-io/dgo/v2/protos/api"
+	"github.com/dgraph-io/dgraph/edgraph"
+	"github.com/dgraph-io/dgraph/gql"
+	"github.com/dgraph-io/dgraph/graphql/schema"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+)
+
+// dgraphPredicate and dgraphType are the predicates and types returned by a schema {} query.
+// Unlike there, the flags of a predicate are returned even if they're false.
+type dgraphPredicate struct {
+	Predicate string   `json:"predicate"`
+	Type      string   `json:"type"`
+	Index     bool     `json:"index"`
+	Tokenizer []string `json:"tokenizer"`
+	Reverse   bool     `json:"reverse"`
+	Count     bool     `json:"count"`
+	List      bool     `json:"list"`
+	Upsert    bool     `json:"upsert"`
+	Lang      bool     `json:"lang"`
+}
+
+type dgraphType struct {
+	Name   string `json:"name"`
+	Fields []struct {
+		Name string `json:"name"`
+	} `json:"fields"`
+}
+
+// dgraphSchema is the result of a schema {} query.
+type dgraphSchema struct {
+	Schema []dgraphPredicate `json:"schema"`
+	Types  []dgraphType      `json:"types"`
+}
+
+// queryDgraphSchema returns the Dgraph schema by running a schema {} query, which is authorized
+// like any other.
+func queryDgraphSchema(ctx context.Context) (*dgraphSchema, error) {
+	resp, err := (&edgraph.Server{}).Query(ctx, &dgoapi.Request{
+		Query:    "schema {}",
+		ReadOnly: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var res dgraphSchema
+	if err := json.Unmarshal(resp.GetJson(), &res); err != nil {
+		return nil, errors.Wrapf(err, "couldn't unmarshal the Dgraph schema")
+	}
+	return &res, nil
+}
+
+// dgraphSchemaResolver resolves dgraphSchema, which returns the Dgraph schema in the same form
+// as a schema {} query. The query is authorized like any other, so with ACL, only the
+// predicates the user can read are returned.
+type dgraphSchemaResolver struct {
+}
+
+func (dr *dgraphSchemaResolver) Rewrite(q schema.Query) (*gql.GraphQuery, error) {
+	glog.Info("Got dgraphSchema request through GraphQL admin API")
+	return nil, nil
+}
+
+func (dr *dgraphSchemaResolver) Query(ctx context.Context, query *gql.GraphQuery) ([]byte, error) {
+	res, err := queryDgraphSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+	b, err := json.Marshal(map[string]interface{}{"dgraphSchema": res})
+	return b, errors.Wrapf(err, "couldn't marshal the Dgraph schema")
+}
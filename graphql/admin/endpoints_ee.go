@@ -36,6 +36,13 @@ const adminTypes = `
 		userId: String
 		password: String
 		refreshToken: String
+		# externalJWT is a JWT issued by the identity provider set by --acl_jwks_url. If it's
+		# set, the groups in its claims are used as the groups of the user, and no refreshJWT is
+		# returned.
+		externalJWT: String
+		# code is the current TOTP code of a user with MFA enabled. It's required when logging
+		# in with the password of such a user.
+		code: String
 	}
 
 	type LoginResponse {
@@ -47,17 +54,36 @@ const adminTypes = `
 		response: LoginResponse
 	}
 
+	type MFAResponse {
+		# otpauthURI holds the TOTP secret of the user, to be added to an authenticator app.
+		otpauthURI: String
+	}
+
+	type EnableMFAPayload {
+		response: MFAResponse
+	}
+
 	type User {
 		name: String! @id @dgraph(pred: "dgraph.xid")
 		# TODO - Update this to actual secret after password PR is merged here.
 		password: String! @dgraph(pred: "dgraph.password")
 		groups: [Group] @dgraph(pred: "dgraph.user.group")
+		# A disabled user can't log in, but keeps its groups so that it can be enabled again.
+		disabled: Boolean @dgraph(pred: "dgraph.user.disabled")
+		# rules are set directly on the user by setUserRules. They apply to the user in addition
+		# to the rules of its groups.
+		rules: [Rule] @dgraph(pred: "dgraph.acl.rule")
 	}
 
 	type Group {
 		name: String! @id @dgraph(pred: "dgraph.xid")
 		users: [User] @dgraph(pred: "~dgraph.user.group")
 		rules: [Rule] @dgraph(pred: "dgraph.acl.rule")
+		# defaultPermission is the permission the members of the group have on the predicates the
+		# group has no rule for, e.g. 4 to let them read everything except what deny rules take
+		# away. It never applies to the reserved predicates, like dgraph.type and the ACL
+		# predicates, which are only accessible through rules or as a guardian.
+		defaultPermission: Int @dgraph(pred: "dgraph.group.default_permission")
 	}
 
 	type Rule {
@@ -69,6 +95,14 @@ const adminTypes = `
 		# If we change permission to be an ENUM and only allow ACL mutations through the GraphQL API
 		# then we don't need this validation in Dgrpah.
 		permission: Int! @dgraph(pred: "dgraph.rule.permission")
+		# If deny is set, the rule denies permission on predicate instead of granting it. A deny
+		# rule takes precedence over every rule that grants the same permission, including the
+		# rules of the user's other groups.
+		deny: Boolean @dgraph(pred: "dgraph.rule.deny")
+		# If filter is set, the read permission granted by the rule only covers the nodes that
+		# match it. It has the syntax of a @filter directive, e.g. eq(department, "eng"), and is
+		# ANDed into the filter of every query block that reads predicate.
+		filter: String @dgraph(pred: "dgraph.rule.filter")
 	}
 
 	input StringHashFilter {
@@ -92,6 +126,14 @@ const adminTypes = `
 	input AddGroupInput {
 		name: String!
 		rules: [RuleRef]
+		defaultPermission: Int
+	}
+
+	input AddGroupWithMembersInput {
+		name: String!
+		rules: [RuleRef!]
+		# users are the names of the users to add to the group, which must already exist.
+		users: [String!]
 	}
 
 	input UserRef {
@@ -106,6 +148,8 @@ const adminTypes = `
 		id: ID
 		predicate: String
 		permission: Int
+		deny: Boolean
+		filter: String
 	}
 
 	input UserFilter {
@@ -147,6 +191,7 @@ const adminTypes = `
 
 	input GroupPatch {
 		rules: [RuleRef]
+		defaultPermission: Int
 	}
 
 	input UpdateGroupInput {
@@ -169,12 +214,113 @@ const adminTypes = `
 
 	type DeleteGroupPayload {
 		msg: String
+	}
+
+	type PermissionGrant {
+		group: String
+		ruleId: String
+		permission: Int
+		deny: Boolean
+	}
+
+	type EffectivePermission {
+		user: String
+		predicate: String
+		# permission is the union of the permissions of all the grants, without the permissions
+		# denied by any of them.
+		permission: Int
+		grants: [PermissionGrant]
+	}
+
+	type AccessEvaluation {
+		# allowed and denied are the predicates read by the query that can and can't be read.
+		allowed: [String]
+		denied: [String]
+	}
+
+	type ACLCacheRule {
+		predicate: String
+		permission: Int
+		deny: Boolean
+		filter: String
+	}
+
+	type ACLCacheGroup {
+		name: String
+		rules: [ACLCacheRule]
+		defaultPermission: Int
+	}
+
+	type ACLCache {
+		# lastRefresh is the unix time of the last refresh of the cache, or 0 if it hasn't been
+		# refreshed.
+		lastRefresh: Int
+		userCount: Int
+		# groups are the groups that have rules or a default permission.
+		groups: [ACLCacheGroup]
+	}
+
+	type ACLAuditEntry {
+		timestamp: String
+		actor: String
+		action: String
+		target: String
+	}
+
+	type RemovedRule {
+		group: String
+		predicate: String
+	}
+
+	type GcACLResponse {
+		# rules are the rules that were removed, and groups the names of the groups that were
+		# deleted.
+		rules: [RemovedRule]
+		groups: [String]
+	}
+
+	type GcACLPayload {
+		response: GcACLResponse
+	}
+
+	type GroupValidation {
+		name: String
+		# valid is true if the group has no dangling rules and no missing members.
+		valid: Boolean
+		# danglingRules are the predicates of the rules of the group that aren't in the schema,
+		# and missingMembers the uids of the members of the group that aren't users anymore.
+		danglingRules: [String]
+		missingMembers: [String]
+	}
+
+	type RotateACLSecretPayload {
+		response: Response
+	}
+
+	type UserPage {
+		users: [User]
+		# cursor is passed as the after argument of queryUserPage to get the next page. It's
+		# null on the last page.
+		cursor: String
+	}
+
+	type UserAggregateResult {
+		count: Int
+	}
+
+	type GroupAggregateResult {
+		count: Int
 	}`
 
 const adminMutations = `
 	backup(input: BackupInput!) : BackupPayload
 
 	login(input: LoginInput!): LoginPayload
+
+	# impersonate returns an access JWT with the groups of user, so that guardians can check what
+	# that user has access to. Only guardians can impersonate users, and no refresh JWT is
+	# returned.
+	impersonate(user: String!): LoginPayload
 	# ACL related endpoints.
 	# 1. If user and group don't exist both are created and linked.
 	# 2. If user doesn't exist but group does, then user is created and both are linked.
@@ -184,6 +330,11 @@ const adminMutations = `
 	addUser(input: [AddUserInput]): AddUserPayload
 	addGroup(input: [AddGroupInput]): AddGroupPayload
 
+	# addGroupWithMembers creates a group with its rules and adds users to it, in a single
+	# transaction. It fails without changing anything if the group already exists or one of the
+	# users doesn't. Rules are validated like for setGroupRules.
+	addGroupWithMembers(input: AddGroupWithMembersInput!): AddGroupPayload
+
 	# update user allows updating a user's password or updating their groups. If the group
 	# doesn't exist, then it is created, otherwise linked to the user. If the user filter
 	# doesn't return anything then nothing happens.
@@ -191,6 +342,61 @@ const adminMutations = `
 	# update group only allows adding rules to a group.
 	updateGroup(input: UpdateGroupInput!): AddGroupPayload
 
+	# removeUserFromAllGroups removes the user from every group it belongs to.
+	removeUserFromAllGroups(name: String!): AddUserPayload
+
+	# copyUserGroups adds the user to to every group the user from is a member of. If replace is
+	# true, to is also removed from the groups from isn't a member of. The groups of groot can't
+	# be copied.
+	copyUserGroups(from: String!, to: String!, replace: Boolean): AddUserPayload
+
+	# updateUserPassword sets the password of the user name, which can be groot. The password
+	# must be given twice, as password and confirmPassword, so that a mistyped password can't
+	# lock the user out. Access JWTs issued before the password was changed stay valid until
+	# they expire.
+	updateUserPassword(name: String!, password: String!,
+		confirmPassword: String!): AddUserPayload
+
+	# setUserEnabled disables or enables the user name. Logging in as a disabled user fails,
+	# but access JWTs issued before the user was disabled stay valid until they expire.
+	setUserEnabled(name: String!, enabled: Boolean!): AddUserPayload
+
+	# enableMFA gives the user name a new TOTP secret, after which logging in with its password
+	# also requires the current TOTP code. It replaces any secret the user already had.
+	enableMFA(name: String!): EnableMFAPayload
+	# resetMFA removes the TOTP secret of the user name, so that it can log in with just its
+	# password.
+	resetMFA(name: String!): AddUserPayload
+
+	# copyGroupRules adds the rules of group from to group to. If to already has a rule for
+	# the predicate of a copied rule, that rule is replaced if overwrite is true and the copied
+	# rule is skipped otherwise.
+	copyGroupRules(from: String!, to: String!, overwrite: Boolean): AddGroupPayload
+
+	# setGroupRules makes rules the only rules of the group name, in a single transaction. Rules
+	# are matched to the existing rules of the group by predicate, so they can't have an id.
+	setGroupRules(name: String!, rules: [RuleRef!]!): AddGroupPayload
+
+	# addRulesToGroups adds rules to every group in names, in a single transaction. The rules
+	# replace the rules of each group for the same predicates, and no group is changed if one of
+	# them doesn't exist.
+	addRulesToGroups(names: [String!]!, rules: [RuleRef!]!): AddGroupPayload
+
+	# setUserRules makes rules the only rules set directly on the user name, like setGroupRules
+	# does for a group. This grants the user permissions without making it a member of a group.
+	setUserRules(name: String!, rules: [RuleRef!]!): AddUserPayload
+
+	# rotateACLSecret re-reads the file set by --acl_secret_file and signs JWTs with the secret
+	# in it from then on. Access JWTs signed with the previous secret are accepted for another
+	# --acl_access_ttl. It only rotates the secret of the alpha that resolves it, so it must be
+	# run on every alpha after their secret files are updated.
+	rotateACLSecret: RotateACLSecretPayload
+
+	# gcACL removes the rules for predicates that aren't in the schema. If deleteEmptyGroups is
+	# true, it also deletes the groups that are then left with no rules and no members, except
+	# guardians.
+	gcACL(deleteEmptyGroups: Boolean): GcACLPayload
+
 	deleteGroup(filter: GroupFilter!): DeleteGroupPayload
 	deleteUser(filter: UserFilter!): DeleteUserPayload`
 
@@ -205,5 +411,52 @@ const adminQueries = `
 	# TODO - This needs a custom handler. Implement this later.
 	# getCurrentUser: User
 
-	queryUser(filter: UserFilter): [User]
-	queryGroup(filter: GroupFilter): [Group]`
+	# queryUser returns the users that match filter. If group is set, only the members of that
+	# group are returned.
+	queryUser(filter: UserFilter, group: String, order: UserOrder, first: Int,
+		offset: Int): [User]
+	queryGroup(filter: GroupFilter, order: GroupOrder, first: Int, offset: Int): [Group]
+
+	# queryUserPage returns the users that match filter, ordered by name, first at a time. The
+	# cursor returned with a page is passed as after to get the users that follow it, so that
+	# large directories can be read a page at a time.
+	queryUserPage(filter: UserFilter, group: String, first: Int, after: String): UserPage
+
+	# aggregateUser and aggregateGroup return the total number of users and groups, so that
+	# clients can page through queryUser and queryGroup.
+	aggregateUser: UserAggregateResult
+	aggregateGroup: GroupAggregateResult
+
+	# effectivePermission returns the permission user has on predicate, along with the rules
+	# of the user's groups that grant it.
+	effectivePermission(user: String!, predicate: String!): EffectivePermission
+
+	# readablePredicates returns the predicates user can read, through the rules or default
+	# permissions of the user's groups or a rule set on the user. Guardians can read every
+	# predicate in the schema.
+	readablePredicates(user: String!): [String]
+
+	# groupsWithAccessTo returns the rules of every group that has a rule on predicate.
+	groupsWithAccessTo(predicate: String!): [PermissionGrant]
+
+	# evaluateAccess returns which of the predicates read by query the members of group can read.
+	# If rules are given, the group is evaluated as if they were its only rules, so that rules can
+	# be tried out before they're added.
+	evaluateAccess(group: String!, query: String!, rules: [RuleRef!]): AccessEvaluation
+
+	# isPredicateProtected returns whether any group has a rule on predicate, so that it's known
+	# whether dropping or renaming predicate affects the ACL rules.
+	isPredicateProtected(predicate: String!): Boolean
+
+	# validateGroup reports the rules of group on predicates that aren't in the schema, and the
+	# members of group that don't exist anymore. Unlike gcACL, it doesn't remove them.
+	validateGroup(name: String!): GroupValidation
+
+	# queryACLAudit returns the changes made to users, groups and rules, as recorded in the file
+	# set by --acl_audit_file. since and until are RFC 3339 timestamps that limit the time range.
+	queryACLAudit(since: String, until: String): [ACLAuditEntry]
+
+	# aclCache returns what the ACL cache of the alpha serving the request holds. Each alpha
+	# refreshes its cache every --acl_cache_ttl, so changes to the rules can take that long to
+	# be reflected in it.
+	aclCache: ACLCache`
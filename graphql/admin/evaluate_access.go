@@ -0,0 +1,79 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package admin
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/dgraph-io/dgraph/edgraph"
+	"github.com/dgraph-io/dgraph/gql"
+	"github.com/dgraph-io/dgraph/graphql/schema"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+)
+
+// evaluateAccessResolver resolves evaluateAccess by dry running the authorization of a query
+// for the members of a group, against either the rules of the group or the rules given.
+type evaluateAccessResolver struct {
+	group string
+	query string
+	rules []edgraph.AclCacheRule
+}
+
+func (er *evaluateAccessResolver) Rewrite(q schema.Query) (*gql.GraphQuery, error) {
+	glog.Info("Got evaluateAccess request through GraphQL admin API")
+
+	er.group, _ = q.ArgValue("group").(string)
+	er.query, _ = q.ArgValue("query").(string)
+	input, ok := q.ArgValue("rules").([]interface{})
+	if !ok {
+		return nil, nil
+	}
+
+	for _, r := range input {
+		rule, _ := r.(map[string]interface{})
+		if err := validateRule(rule); err != nil {
+			return nil, err
+		}
+	}
+	rules, err := rulesByPredicate(input)
+	if err != nil {
+		return nil, err
+	}
+	er.rules = make([]edgraph.AclCacheRule, 0, len(rules))
+	for _, rule := range rules {
+		er.rules = append(er.rules, edgraph.AclCacheRule{
+			Predicate:  rule.Predicate,
+			Permission: rule.Permission,
+			Deny:       rule.Deny,
+			Filter:     rule.Filter,
+		})
+	}
+	return nil, nil
+}
+
+func (er *evaluateAccessResolver) Query(ctx context.Context,
+	query *gql.GraphQuery) ([]byte, error) {
+	eval, err := edgraph.EvaluateAccess(er.group, er.rules, er.query)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := json.Marshal(map[string]interface{}{"evaluateAccess": eval})
+	return b, errors.Wrapf(err, "couldn't marshal the access of %s", er.group)
+}
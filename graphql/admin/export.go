@@ -17,6 +17,7 @@
 package admin
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 
@@ -24,16 +25,19 @@ import (
 	"github.com/dgraph-io/dgraph/gql"
 	"github.com/dgraph-io/dgraph/graphql/schema"
 	"github.com/dgraph-io/dgraph/worker"
+	"github.com/dgraph-io/dgraph/x"
 	"github.com/golang/glog"
 	"github.com/pkg/errors"
 )
 
 type exportResolver struct {
 	mutation schema.Mutation
+	taskId   string
 }
 
 type exportInput struct {
-	Format string
+	Format      string
+	Destination string
 }
 
 func (er *exportResolver) Rewrite(
@@ -54,8 +58,16 @@ func (er *exportResolver) Rewrite(
 		}
 	}
 
-	err = worker.ExportOverNetwork(context.Background(), format)
-	return nil, nil, errors.Wrapf(err, "export failed")
+	// Exports can take a long time, so they're run as a task that can be polled with the task
+	// query.
+	er.taskId = tasks.run("export", func(setProgress func(float64)) error {
+		err := worker.ExportOverNetwork(context.Background(), format, input.Destination,
+			func(done, total int) {
+				setProgress(float64(done) * 100 / float64(total))
+			})
+		return errors.Wrapf(err, "export failed")
+	})
+	return nil, nil, nil
 }
 
 func (er *exportResolver) FromMutationResult(
@@ -75,8 +87,32 @@ func (er *exportResolver) Mutate(
 }
 
 func (er *exportResolver) Query(ctx context.Context, query *gql.GraphQuery) ([]byte, error) {
-	buf := writeResponse(er.mutation, "Success", "Export completed.")
-	return buf, nil
+	var buf bytes.Buffer
+
+	x.Check2(buf.WriteString(`{ "`))
+	x.Check2(buf.WriteString(er.mutation.SelectionSet()[0].ResponseName() + `": [{`))
+
+	for i, sel := range er.mutation.SelectionSet()[0].SelectionSet() {
+		var val string
+		switch sel.Name() {
+		case "code":
+			val = "Success"
+		case "message":
+			val = "Export started."
+		case "taskId":
+			val = er.taskId
+		}
+		if i != 0 {
+			x.Check2(buf.WriteString(","))
+		}
+		x.Check2(buf.WriteString(`"`))
+		x.Check2(buf.WriteString(sel.ResponseName()))
+		x.Check2(buf.WriteString(`":`))
+		x.Check2(buf.WriteString(`"` + val + `"`))
+	}
+	x.Check2(buf.WriteString("}]}"))
+
+	return buf.Bytes(), nil
 }
 
 func getExportInput(m schema.Mutation) (*exportInput, error) {
@@ -0,0 +1,198 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package admin
+
+import (
+	"context"
+	"encoding/json"
+
+	dgoapi "github.com/dgraph-io/dgo/v2/protos/api"
+	"github.com/dgraph-io/dgraph/gql"
+	"github.com/dgraph-io/dgraph/graphql/resolve"
+	"github.com/dgraph-io/dgraph/graphql/schema"
+	"github.com/dgraph-io/dgraph/x"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+)
+
+// removedRule is a rule removed by gcACL.
+type removedRule struct {
+	group     string
+	predicate string
+}
+
+// gcACLResolver resolves gcACL, which removes the rules for predicates that aren't in the schema
+// and, if deleteEmptyGroups is set, the groups that are left with no rules and no members.
+type gcACLResolver struct {
+	mutation          schema.Mutation
+	deleteEmptyGroups bool
+
+	removedRules  []removedRule
+	removedGroups []string
+}
+
+func (gr *gcACLResolver) Rewrite(
+	m schema.Mutation) (*gql.GraphQuery, []*dgoapi.Mutation, error) {
+	glog.Info("Got gcACL request through GraphQL admin API")
+
+	gr.mutation = m
+	gr.deleteEmptyGroups, _ = m.ArgValue("deleteEmptyGroups").(bool)
+	return nil, nil, nil
+}
+
+func (gr *gcACLResolver) FromMutationResult(
+	mutation schema.Mutation,
+	assigned map[string]string,
+	result map[string]interface{}) (*gql.GraphQuery, error) {
+
+	return nil, nil
+}
+
+func (gr *gcACLResolver) Mutate(
+	ctx context.Context,
+	query *gql.GraphQuery,
+	mutations []*dgoapi.Mutation) (map[string]string, map[string]interface{}, error) {
+
+	groupsQuery := &gql.GraphQuery{
+		Attr: "groups",
+		Func: &gql.Function{
+			Name: "type",
+			Args: []gql.Arg{{Value: "Group"}},
+		},
+		Children: []*gql.GraphQuery{
+			{Attr: "uid"},
+			{Attr: "dgraph.xid"},
+			{
+				Attr: "dgraph.acl.rule",
+				Children: []*gql.GraphQuery{
+					{Attr: "uid"},
+					{Attr: "dgraph.rule.predicate"},
+				},
+			},
+			{
+				Attr:     "~dgraph.user.group",
+				Children: []*gql.GraphQuery{{Attr: "uid"}},
+			},
+		},
+	}
+	resp, err := resolve.AdminQueryExecutor().Query(ctx, groupsQuery)
+	if err != nil {
+		return nil, nil, err
+	}
+	var res struct {
+		Groups []struct {
+			Uid     string    `json:"uid"`
+			Name    string    `json:"dgraph.xid"`
+			Rules   []aclRule `json:"dgraph.acl.rule"`
+			Members []struct {
+				Uid string `json:"uid"`
+			} `json:"~dgraph.user.group"`
+		} `json:"groups"`
+	}
+	if err := json.Unmarshal(resp, &res); err != nil {
+		return nil, nil, errors.Wrapf(err, "couldn't unmarshal groups")
+	}
+
+	var preds []string
+	for _, group := range res.Groups {
+		for _, rule := range group.Rules {
+			preds = append(preds, rule.Predicate)
+		}
+	}
+	found := make(map[string]bool)
+	if len(preds) > 0 {
+		if found, err = schemaPredicates(ctx, preds); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var deletes []interface{}
+	for _, group := range res.Groups {
+		var orphaned []interface{}
+		for _, rule := range group.Rules {
+			if found[rule.Predicate] {
+				continue
+			}
+			orphaned = append(orphaned, map[string]interface{}{"uid": rule.Uid})
+			gr.removedRules = append(gr.removedRules, removedRule{
+				group:     group.Name,
+				predicate: rule.Predicate,
+			})
+		}
+
+		// The reserved groups are never deleted, so that they can't be lost by accident.
+		if gr.deleteEmptyGroups && len(orphaned) == len(group.Rules) &&
+			len(group.Members) == 0 && !x.IsReservedGroup(group.Name) {
+			deletes = append(deletes, map[string]interface{}{"uid": group.Uid})
+			gr.removedGroups = append(gr.removedGroups, group.Name)
+		} else if len(orphaned) > 0 {
+			deletes = append(deletes, map[string]interface{}{
+				"uid":             group.Uid,
+				"dgraph.acl.rule": orphaned,
+			})
+		}
+		// The rule nodes are deleted along with the edges to them.
+		deletes = append(deletes, orphaned...)
+	}
+	if len(deletes) == 0 {
+		return nil, nil, nil
+	}
+
+	deleteJSON, err := json.Marshal(deletes)
+	if err != nil {
+		return nil, nil, err
+	}
+	glog.Infof("Removing %d ACL rules and %d groups", len(gr.removedRules),
+		len(gr.removedGroups))
+	return resolve.DgraphAsMutationExecutor().Mutate(ctx, nil,
+		[]*dgoapi.Mutation{{DeleteJson: deleteJSON}})
+}
+
+func (gr *gcACLResolver) Query(ctx context.Context, query *gql.GraphQuery) ([]byte, error) {
+	payload := gr.mutation.SelectionSet()[0]
+	response := make(map[string]interface{})
+	for _, sel := range payload.SelectionSet() {
+		switch sel.Name() {
+		case "rules":
+			rules := make([]interface{}, 0, len(gr.removedRules))
+			for _, rule := range gr.removedRules {
+				ruleJSON := make(map[string]interface{})
+				for _, f := range sel.SelectionSet() {
+					switch f.Name() {
+					case "group":
+						ruleJSON[f.ResponseName()] = rule.group
+					case "predicate":
+						ruleJSON[f.ResponseName()] = rule.predicate
+					}
+				}
+				rules = append(rules, ruleJSON)
+			}
+			response[sel.ResponseName()] = rules
+		case "groups":
+			groups := gr.removedGroups
+			if groups == nil {
+				groups = []string{}
+			}
+			response[sel.ResponseName()] = groups
+		}
+	}
+
+	b, err := json.Marshal(map[string]interface{}{
+		payload.ResponseName(): []interface{}{response},
+	})
+	return b, errors.Wrapf(err, "couldn't marshal the removed rules and groups")
+}
@@ -19,19 +19,30 @@ package admin
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/dgraph-io/dgo/v2/protos/api"
 	"github.com/dgraph-io/dgraph/edgraph"
 	"github.com/dgraph-io/dgraph/gql"
 	"github.com/dgraph-io/dgraph/graphql/schema"
+	"github.com/dgraph-io/dgraph/worker"
 	"github.com/dgraph-io/dgraph/x"
 	"github.com/pkg/errors"
 )
 
 type healthResolver struct {
+	admin *adminServer
+	query schema.Query
 }
 
 func (hr *healthResolver) Rewrite(q schema.Query) (*gql.GraphQuery, error) {
+	hr.query = q
 	return nil, nil
 }
 
@@ -47,10 +58,191 @@ func (hr *healthResolver) Query(ctx context.Context, query *gql.GraphQuery) ([]b
 		err = errors.Errorf("%s: %s", x.ErrorNoData, "No state information available.")
 	}
 
+	healthJson := []byte("null")
+	if resp != nil {
+		var aclErr error
+		if healthJson, aclErr = hr.withLocalStatus(resp.Json); aclErr != nil {
+			return nil, aclErr
+		}
+	}
+
 	var buf bytes.Buffer
 	x.Check2(buf.WriteString(`{ "health":`))
-	x.Check2(buf.Write(resp.Json))
+	x.Check2(buf.Write(healthJson))
 	x.Check2(buf.WriteString(`}`))
 
 	return buf.Bytes(), err
 }
+
+// withLocalStatus adds the state of ACL, the GraphQL schema being served and the maintenance
+// message to the health of this alpha in healthJson. The other nodes only get whether they're the
+// leader of their group, from the membership state, as the rest of their state isn't known here.
+func (hr *healthResolver) withLocalStatus(healthJson []byte) ([]byte, error) {
+	var health []map[string]interface{}
+	if err := json.Unmarshal(healthJson, &health); err != nil {
+		return nil, errors.Wrapf(err, "couldn't unmarshal health")
+	}
+
+	hr.admin.mux.Lock()
+	served := hr.admin.schema
+	hr.admin.mux.Unlock()
+
+	leaders := make(map[string]bool)
+	state := worker.GetMembershipState()
+	for _, m := range state.GetZeros() {
+		leaders[m.Addr] = m.Leader
+	}
+	for _, group := range state.GetGroups() {
+		for _, m := range group.Members {
+			leaders[m.Addr] = m.Leader
+		}
+	}
+
+	for _, node := range health {
+		if addr, ok := node["address"].(string); ok {
+			node["leader"] = leaders[addr]
+		}
+		if node["instance"] == "alpha" && node["address"] == x.WorkerConfig.MyAddr {
+			node["acl"] = edgraph.AclHealth()
+			if served.Schema != "" {
+				node["graphqlSchemaVersion"] = schemaVersion(served.Schema)
+			}
+			if served.UpdatedAt != "" {
+				node["graphqlSchemaUpdatedAt"] = served.UpdatedAt
+			}
+			if msg := x.MaintenanceMessage(); msg != "" {
+				node["maintenanceMessage"] = msg
+			}
+		}
+	}
+	health, err := filterHealth(health, hr.query)
+	if err != nil {
+		return nil, err
+	}
+	b, err := json.Marshal(health)
+	return b, errors.Wrapf(err, "couldn't marshal health")
+}
+
+// filterHealth returns the nodes of health that match the group and status arguments of the
+// health query q, sorted by its order argument and limited by its first and offset arguments.
+func filterHealth(health []map[string]interface{}, q schema.Query) (
+	[]map[string]interface{}, error) {
+	if q == nil {
+		return health, nil
+	}
+
+	group := ""
+	if arg := q.ArgValue("group"); arg != nil {
+		num, err := ruleNumber(arg)
+		if err != nil {
+			return nil, errors.Wrapf(err, "group")
+		}
+		group = strconv.FormatInt(num, 10)
+	}
+	status, _ := q.ArgValue("status").(string)
+
+	filtered := make([]map[string]interface{}, 0, len(health))
+	for _, node := range health {
+		if group != "" && node["group"] != group {
+			continue
+		}
+		if status != "" && node["status"] != status {
+			continue
+		}
+		filtered = append(filtered, node)
+	}
+
+	if order, ok := q.ArgValue("order").(map[string]interface{}); ok {
+		sort.SliceStable(filtered, func(i, j int) bool {
+			return healthLess(filtered[i], filtered[j], order)
+		})
+	}
+
+	if arg := q.ArgValue("offset"); arg != nil {
+		offset, err := ruleNumber(arg)
+		if err != nil {
+			return nil, errors.Wrapf(err, "offset")
+		}
+		if offset >= int64(len(filtered)) {
+			offset = int64(len(filtered))
+		}
+		if offset > 0 {
+			filtered = filtered[offset:]
+		}
+	}
+	if arg := q.ArgValue("first"); arg != nil {
+		first, err := ruleNumber(arg)
+		if err != nil {
+			return nil, errors.Wrapf(err, "first")
+		}
+		if first >= 0 && first < int64(len(filtered)) {
+			filtered = filtered[:first]
+		}
+	}
+	return filtered, nil
+}
+
+// healthLess reports whether node a comes before node b in order, which is a NodeStateOrder.
+func healthLess(a, b map[string]interface{}, order map[string]interface{}) bool {
+	field, desc := order["asc"], false
+	if field == nil {
+		field, desc = order["desc"], true
+	}
+	name, _ := field.(string)
+
+	cmp := compareHealthField(a[name], b[name])
+	if cmp == 0 {
+		if then, ok := order["then"].(map[string]interface{}); ok {
+			return healthLess(a, b, then)
+		}
+		return false
+	}
+	if desc {
+		return cmp > 0
+	}
+	return cmp < 0
+}
+
+// compareHealthField compares two values of a field of NodeState, as unmarshalled from the
+// health JSON. Groups are compared as numbers, although they're strings in the JSON, and missing
+// values come first.
+func compareHealthField(a, b interface{}) int {
+	toNumber := func(v interface{}) (float64, bool) {
+		switch v := v.(type) {
+		case float64:
+			return v, true
+		case string:
+			n, err := strconv.ParseFloat(v, 64)
+			return n, err == nil
+		}
+		return 0, false
+	}
+
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return -1
+	case b == nil:
+		return 1
+	}
+	if an, ok := toNumber(a); ok {
+		if bn, ok := toNumber(b); ok {
+			switch {
+			case an < bn:
+				return -1
+			case an > bn:
+				return 1
+			}
+			return 0
+		}
+	}
+	return strings.Compare(fmt.Sprint(a), fmt.Sprint(b))
+}
+
+// schemaVersion identifies the GraphQL schema sch. Alphas serving the same schema report the
+// same version.
+func schemaVersion(sch string) string {
+	sum := sha256.Sum256([]byte(sch))
+	return hex.EncodeToString(sum[:])
+}
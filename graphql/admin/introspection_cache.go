@@ -0,0 +1,69 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package admin
+
+import (
+	"context"
+	"fmt"
+
+	dgoapi "github.com/dgraph-io/dgo/v2/protos/api"
+	"github.com/dgraph-io/dgraph/gql"
+	"github.com/dgraph-io/dgraph/graphql/schema"
+	"github.com/golang/glog"
+)
+
+// invalidateIntrospectionResolver drops the introspection results cached for the schema
+// served at /graphql. They are otherwise only dropped when the GraphQL schema changes.
+type invalidateIntrospectionResolver struct {
+	admin    *adminServer
+	mutation schema.Mutation
+	version  uint64
+}
+
+func (ir *invalidateIntrospectionResolver) Rewrite(
+	m schema.Mutation) (*gql.GraphQuery, []*dgoapi.Mutation, error) {
+	glog.Info("Got invalidateIntrospectionCache request through GraphQL admin API")
+
+	ir.mutation = m
+	ir.admin.mux.Lock()
+	defer ir.admin.mux.Unlock()
+	ir.version = schema.InvalidateIntrospection(ir.admin.servedSchema)
+	return nil, nil, nil
+}
+
+func (ir *invalidateIntrospectionResolver) FromMutationResult(
+	mutation schema.Mutation,
+	assigned map[string]string,
+	result map[string]interface{}) (*gql.GraphQuery, error) {
+
+	return nil, nil
+}
+
+func (ir *invalidateIntrospectionResolver) Mutate(
+	ctx context.Context,
+	query *gql.GraphQuery,
+	mutations []*dgoapi.Mutation) (map[string]string, map[string]interface{}, error) {
+
+	return nil, nil, nil
+}
+
+func (ir *invalidateIntrospectionResolver) Query(
+	ctx context.Context, query *gql.GraphQuery) ([]byte, error) {
+	buf := writeResponse(ir.mutation, "Success",
+		fmt.Sprintf("Introspection cache invalidated, now at version %d", ir.version))
+	return buf, nil
+}
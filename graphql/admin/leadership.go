@@ -0,0 +1,119 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package admin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	dgoapi "github.com/dgraph-io/dgo/v2/protos/api"
+	"github.com/dgraph-io/dgraph/gql"
+	"github.com/dgraph-io/dgraph/graphql/schema"
+	"github.com/dgraph-io/dgraph/worker"
+	"github.com/dgraph-io/dgraph/x"
+	"github.com/golang/glog"
+)
+
+type transferLeadershipResolver struct {
+	mutation schema.Mutation
+	input    *transferLeadershipInput
+	leader   string
+}
+
+type transferLeadershipInput struct {
+	Group uint32
+	To    string
+}
+
+func (tr *transferLeadershipResolver) Rewrite(
+	m schema.Mutation) (*gql.GraphQuery, []*dgoapi.Mutation, error) {
+	glog.Info("Got transferLeadership request through GraphQL admin API")
+
+	tr.mutation = m
+	input, err := getTransferLeadershipInput(m)
+	if err != nil {
+		return nil, nil, err
+	}
+	tr.input = input
+	return nil, nil, nil
+}
+
+func (tr *transferLeadershipResolver) FromMutationResult(
+	mutation schema.Mutation,
+	assigned map[string]string,
+	result map[string]interface{}) (*gql.GraphQuery, error) {
+
+	return nil, nil
+}
+
+func (tr *transferLeadershipResolver) Mutate(
+	ctx context.Context,
+	query *gql.GraphQuery,
+	mutations []*dgoapi.Mutation) (map[string]string, map[string]interface{}, error) {
+
+	leader, err := worker.TransferLeadership(ctx, tr.input.Group, tr.input.To)
+	if err != nil {
+		return nil, nil, schema.GQLWrapf(err, "couldn't transfer the leadership of group %d",
+			tr.input.Group)
+	}
+	tr.leader = leader
+	return nil, nil, nil
+}
+
+func (tr *transferLeadershipResolver) Query(
+	ctx context.Context, query *gql.GraphQuery) ([]byte, error) {
+	var buf bytes.Buffer
+
+	x.Check2(buf.WriteString(`{ "`))
+	x.Check2(buf.WriteString(tr.mutation.SelectionSet()[0].ResponseName() + `": [{`))
+
+	for i, sel := range tr.mutation.SelectionSet()[0].SelectionSet() {
+		var val string
+		switch sel.Name() {
+		case "code":
+			val = "Success"
+		case "message":
+			val = fmt.Sprintf("%s is the leader of group %d", tr.leader, tr.input.Group)
+		case "leader":
+			val = tr.leader
+		}
+		if i != 0 {
+			x.Check2(buf.WriteString(","))
+		}
+		x.Check2(buf.WriteString(`"`))
+		x.Check2(buf.WriteString(sel.ResponseName()))
+		x.Check2(buf.WriteString(`":`))
+		x.Check2(buf.WriteString(`"` + val + `"`))
+	}
+	x.Check2(buf.WriteString("}]}"))
+
+	return buf.Bytes(), nil
+}
+
+func getTransferLeadershipInput(m schema.Mutation) (*transferLeadershipInput, error) {
+	inputArg := m.ArgValue(schema.InputArgName)
+	inputByts, err := json.Marshal(inputArg)
+	if err != nil {
+		return nil, schema.GQLWrapf(err, "couldn't get input argument")
+	}
+
+	var input transferLeadershipInput
+	err = json.Unmarshal(inputByts, &input)
+	return &input, schema.GQLWrapf(err, "couldn't get input argument")
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/dgraph-io/dgraph/graphql/schema"
 	"github.com/dgraph-io/dgraph/x"
 	"github.com/golang/glog"
+	"google.golang.org/grpc/metadata"
 )
 
 type loginResolver struct {
@@ -39,6 +40,8 @@ type loginInput struct {
 	UserId       string
 	Password     string
 	RefreshToken string
+	ExternalJWT  string
+	Code         string
 }
 
 func (lr *loginResolver) Rewrite(
@@ -52,11 +55,21 @@ func (lr *loginResolver) Rewrite(
 	}
 
 	// TODO - Fix this context to log the IP as it does in the other request.
-	resp, err := (&edgraph.Server{}).Login(context.Background(), &dgoapi.LoginRequest{
-		Userid:       input.UserId,
-		Password:     input.Password,
-		RefreshToken: input.RefreshToken,
-	})
+	var resp *dgoapi.Response
+	if input.ExternalJWT != "" {
+		resp, err = (&edgraph.Server{}).LoginWithExternalJwt(context.Background(),
+			input.ExternalJWT)
+	} else {
+		ctx := context.Background()
+		if input.Code != "" {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("totp-code", input.Code))
+		}
+		resp, err = (&edgraph.Server{}).Login(ctx, &dgoapi.LoginRequest{
+			Userid:       input.UserId,
+			Password:     input.Password,
+			RefreshToken: input.RefreshToken,
+		})
+	}
 	if err != nil {
 		return nil, nil, err
 	}
@@ -69,6 +82,32 @@ func (lr *loginResolver) Rewrite(
 	return nil, nil, nil
 }
 
+// impersonateResolver resolves the impersonate mutation. It returns the access JWT in the same
+// way as loginResolver, but gets it for the user in the mutation's arguments instead of
+// authenticating a user.
+type impersonateResolver struct {
+	loginResolver
+	ctx context.Context
+}
+
+func (ir *impersonateResolver) Rewrite(
+	m schema.Mutation) (*gql.GraphQuery, []*dgoapi.Mutation, error) {
+	glog.Info("Got impersonate request")
+
+	ir.mutation = m
+	user, _ := m.ArgValue("user").(string)
+	resp, err := (&edgraph.Server{}).Impersonate(ir.ctx, user)
+	if err != nil {
+		return nil, nil, err
+	}
+	jwt := &dgoapi.Jwt{}
+	if err := jwt.Unmarshal(resp.GetJson()); err != nil {
+		return nil, nil, err
+	}
+	ir.accessJwt = jwt.AccessJwt
+	return nil, nil, nil
+}
+
 func (lr *loginResolver) FromMutationResult(
 	mutation schema.Mutation,
 	assigned map[string]string,
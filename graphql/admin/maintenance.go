@@ -0,0 +1,69 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package admin
+
+import (
+	"context"
+
+	dgoapi "github.com/dgraph-io/dgo/v2/protos/api"
+	"github.com/dgraph-io/dgraph/gql"
+	"github.com/dgraph-io/dgraph/graphql/schema"
+	"github.com/dgraph-io/dgraph/x"
+	"github.com/golang/glog"
+)
+
+// maintenanceMessageResolver resolves setMaintenanceMessage, which sets the message reported in
+// the health of this alpha, so that clients can be told why the cluster is under maintenance.
+type maintenanceMessageResolver struct {
+	mutation schema.Mutation
+	msg      string
+}
+
+func (mr *maintenanceMessageResolver) Rewrite(
+	m schema.Mutation) (*gql.GraphQuery, []*dgoapi.Mutation, error) {
+	glog.Info("Got setMaintenanceMessage request through GraphQL admin API")
+
+	mr.mutation = m
+	mr.msg, _ = m.ArgValue("msg").(string)
+	x.SetMaintenanceMessage(mr.msg)
+	return nil, nil, nil
+}
+
+func (mr *maintenanceMessageResolver) FromMutationResult(
+	mutation schema.Mutation,
+	assigned map[string]string,
+	result map[string]interface{}) (*gql.GraphQuery, error) {
+
+	return nil, nil
+}
+
+func (mr *maintenanceMessageResolver) Mutate(
+	ctx context.Context,
+	query *gql.GraphQuery,
+	mutations []*dgoapi.Mutation) (map[string]string, map[string]interface{}, error) {
+
+	return nil, nil, nil
+}
+
+func (mr *maintenanceMessageResolver) Query(
+	ctx context.Context, query *gql.GraphQuery) ([]byte, error) {
+	message := "maintenance message has been set"
+	if mr.msg == "" {
+		message = "maintenance message has been cleared"
+	}
+	return writeResponse(mr.mutation, "Success", message), nil
+}
@@ -0,0 +1,107 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	dgoapi "github.com/dgraph-io/dgo/v2/protos/api"
+	"github.com/dgraph-io/dgraph/edgraph"
+	"github.com/dgraph-io/dgraph/gql"
+	"github.com/dgraph-io/dgraph/graphql/resolve"
+	"github.com/dgraph-io/dgraph/graphql/schema"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+)
+
+// enableMFAResolver resolves the enableMFA mutation. The secret is generated and stored by
+// edgraph, so the resolver only returns its otpauth URI.
+type enableMFAResolver struct {
+	mutation schema.Mutation
+	uri      string
+}
+
+func (er *enableMFAResolver) Rewrite(
+	m schema.Mutation) (*gql.GraphQuery, []*dgoapi.Mutation, error) {
+	glog.Info("Got enableMFA request through GraphQL admin API")
+
+	er.mutation = m
+	return nil, nil, nil
+}
+
+func (er *enableMFAResolver) FromMutationResult(
+	mutation schema.Mutation,
+	assigned map[string]string,
+	result map[string]interface{}) (*gql.GraphQuery, error) {
+
+	return nil, nil
+}
+
+func (er *enableMFAResolver) Mutate(
+	ctx context.Context,
+	query *gql.GraphQuery,
+	mutations []*dgoapi.Mutation) (map[string]string, map[string]interface{}, error) {
+
+	name, _ := er.mutation.ArgValue("name").(string)
+	var err error
+	er.uri, err = edgraph.EnableTotp(ctx, name)
+	return nil, nil, err
+}
+
+func (er *enableMFAResolver) Query(ctx context.Context, query *gql.GraphQuery) ([]byte, error) {
+	field := er.mutation.SelectionSet()[0]
+	response := make(map[string]interface{})
+	for _, sel := range field.SelectionSet() {
+		if sel.Name() == "otpauthURI" {
+			response[sel.ResponseName()] = er.uri
+		}
+	}
+	b, err := json.Marshal(map[string]interface{}{
+		field.ResponseName(): []interface{}{response},
+	})
+	return b, errors.Wrapf(err, "couldn't marshal the response of %s", er.mutation.Name())
+}
+
+// resetMFARewriter rewrites resetMFA into an upsert that deletes the TOTP secret of the named
+// user.
+type resetMFARewriter struct{}
+
+func (rr *resetMFARewriter) Rewrite(
+	m schema.Mutation) (*gql.GraphQuery, []*dgoapi.Mutation, error) {
+	glog.Info("Got resetMFA request through GraphQL admin API")
+
+	name, _ := m.ArgValue("name").(string)
+	deletes, err := json.Marshal(map[string]interface{}{
+		"uid":              fmt.Sprintf("uid(%s)", userQueryVar),
+		"dgraph.user.totp": nil,
+	})
+	if err != nil {
+		return nil, nil, schema.GQLWrapf(err, "couldn't rewrite mutation %s", m.Name())
+	}
+
+	return userUpsertQuery(m, name), []*dgoapi.Mutation{{DeleteJson: deletes}}, nil
+}
+
+func (rr *resetMFARewriter) FromMutationResult(
+	mutation schema.Mutation,
+	assigned map[string]string,
+	result map[string]interface{}) (*gql.GraphQuery, error) {
+
+	return resolve.NewUpdateRewriter().FromMutationResult(mutation, assigned, result)
+}
@@ -0,0 +1,366 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/dgraph-io/dgraph/edgraph"
+	"github.com/dgraph-io/dgraph/gql"
+	"github.com/dgraph-io/dgraph/graphql/resolve"
+	"github.com/dgraph-io/dgraph/graphql/schema"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/worker"
+	"github.com/dgraph-io/dgraph/x"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+)
+
+// aclRule and aclGroup are the parts of the ACL data that are read back from Dgraph to work out
+// the permissions of a user.
+type aclRule struct {
+	Uid        string `json:"uid"`
+	Predicate  string `json:"dgraph.rule.predicate"`
+	Permission int32  `json:"dgraph.rule.permission"`
+	Deny       bool   `json:"dgraph.rule.deny"`
+	Filter     string `json:"dgraph.rule.filter"`
+}
+
+type aclGroup struct {
+	Name              string    `json:"dgraph.xid"`
+	Rules             []aclRule `json:"dgraph.acl.rule"`
+	DefaultPermission int32     `json:"dgraph.group.default_permission"`
+}
+
+type aclUser struct {
+	Name   string     `json:"dgraph.xid"`
+	Groups []aclGroup `json:"dgraph.user.group"`
+}
+
+type permissionGrant struct {
+	Group      string `json:"group"`
+	RuleID     string `json:"ruleId"`
+	Permission int32  `json:"permission"`
+	Deny       bool   `json:"deny"`
+}
+
+type effectivePermission struct {
+	User       string            `json:"user"`
+	Predicate  string            `json:"predicate"`
+	Permission int32             `json:"permission"`
+	Grants     []permissionGrant `json:"grants"`
+}
+
+// effectivePermissionResolver resolves effectivePermission by reading the rules for the
+// predicate from every group of the user and combining their permissions. The groups without a
+// rule granting a permission on the predicate contribute their default permission instead.
+type effectivePermissionResolver struct {
+	user      string
+	predicate string
+}
+
+func (er *effectivePermissionResolver) Rewrite(q schema.Query) (*gql.GraphQuery, error) {
+	glog.Info("Got effectivePermission request through GraphQL admin API")
+
+	er.user, _ = q.ArgValue("user").(string)
+	er.predicate, _ = q.ArgValue("predicate").(string)
+
+	return &gql.GraphQuery{
+		Attr: "user",
+		Func: &gql.Function{
+			Name: "eq",
+			Args: []gql.Arg{{Value: "dgraph.xid"}, {Value: fmt.Sprintf("%q", er.user)}},
+		},
+		Filter: &gql.FilterTree{
+			Func: &gql.Function{
+				Name: "type",
+				Args: []gql.Arg{{Value: "User"}},
+			},
+		},
+		Children: []*gql.GraphQuery{
+			{Attr: "dgraph.xid"},
+			{
+				Attr: "dgraph.user.group",
+				Children: []*gql.GraphQuery{
+					{Attr: "dgraph.xid"},
+					{Attr: "dgraph.group.default_permission"},
+					{
+						Attr: "dgraph.acl.rule",
+						Filter: &gql.FilterTree{
+							Func: &gql.Function{
+								Name: "eq",
+								Args: []gql.Arg{
+									{Value: "dgraph.rule.predicate"},
+									{Value: fmt.Sprintf("%q", er.predicate)},
+								},
+							},
+						},
+						Children: []*gql.GraphQuery{
+							{Attr: "uid"},
+							{Attr: "dgraph.rule.predicate"},
+							{Attr: "dgraph.rule.permission"},
+							{Attr: "dgraph.rule.deny"},
+						},
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+func (er *effectivePermissionResolver) Query(
+	ctx context.Context, query *gql.GraphQuery) ([]byte, error) {
+
+	resp, err := resolve.DgraphAsQueryExecutor().Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var res struct {
+		User []aclUser `json:"user"`
+	}
+	if err := json.Unmarshal(resp, &res); err != nil {
+		return nil, errors.Wrapf(err, "couldn't unmarshal user %s", er.user)
+	}
+	if len(res.User) == 0 {
+		return []byte(`{"effectivePermission": null}`), nil
+	}
+
+	perm := &effectivePermission{
+		User:      er.user,
+		Predicate: er.predicate,
+		Grants:    []permissionGrant{},
+	}
+	var denied int32
+	for _, group := range res.User[0].Groups {
+		hasRule := false
+		for _, rule := range group.Rules {
+			if rule.Deny {
+				denied |= rule.Permission
+			} else {
+				perm.Permission |= rule.Permission
+				hasRule = true
+			}
+			perm.Grants = append(perm.Grants, permissionGrant{
+				Group:      group.Name,
+				RuleID:     rule.Uid,
+				Permission: rule.Permission,
+				Deny:       rule.Deny,
+			})
+		}
+		// Default permissions never apply to the reserved predicates, as in the ACL cache.
+		if !hasRule && !x.IsReservedPredicate(er.predicate) {
+			perm.Permission |= group.DefaultPermission
+		}
+	}
+	// Deny rules override the rules that grant the same permission.
+	perm.Permission &^= denied
+
+	b, err := json.Marshal(map[string]interface{}{"effectivePermission": perm})
+	return b, errors.Wrapf(err, "couldn't marshal permissions of user %s", er.user)
+}
+
+// groupsWithAccessResolver resolves groupsWithAccessTo by reading the rules for the predicate
+// from every group.
+type groupsWithAccessResolver struct {
+	predicate string
+}
+
+func (gr *groupsWithAccessResolver) Rewrite(q schema.Query) (*gql.GraphQuery, error) {
+	glog.Info("Got groupsWithAccessTo request through GraphQL admin API")
+
+	gr.predicate, _ = q.ArgValue("predicate").(string)
+	return groupRulesOnQuery(gr.predicate), nil
+}
+
+// groupRulesOnQuery builds a query that returns every group, along with its rules on predicate.
+func groupRulesOnQuery(predicate string) *gql.GraphQuery {
+	return &gql.GraphQuery{
+		Attr: "groups",
+		Func: &gql.Function{
+			Name: "type",
+			Args: []gql.Arg{{Value: "Group"}},
+		},
+		Order: []*pb.Order{{Attr: "dgraph.xid"}},
+		Children: []*gql.GraphQuery{
+			{Attr: "dgraph.xid"},
+			{
+				Attr: "dgraph.acl.rule",
+				Filter: &gql.FilterTree{
+					Func: &gql.Function{
+						Name: "eq",
+						Args: []gql.Arg{
+							{Value: "dgraph.rule.predicate"},
+							{Value: fmt.Sprintf("%q", predicate)},
+						},
+					},
+				},
+				Children: []*gql.GraphQuery{
+					{Attr: "uid"},
+					{Attr: "dgraph.rule.predicate"},
+					{Attr: "dgraph.rule.permission"},
+					{Attr: "dgraph.rule.deny"},
+				},
+			},
+		},
+	}
+}
+
+func (gr *groupsWithAccessResolver) Query(
+	ctx context.Context, query *gql.GraphQuery) ([]byte, error) {
+
+	resp, err := resolve.DgraphAsQueryExecutor().Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var res struct {
+		Groups []aclGroup `json:"groups"`
+	}
+	if err := json.Unmarshal(resp, &res); err != nil {
+		return nil, errors.Wrapf(err, "couldn't unmarshal groups")
+	}
+
+	grants := []permissionGrant{}
+	for _, group := range res.Groups {
+		for _, rule := range group.Rules {
+			grants = append(grants, permissionGrant{
+				Group:      group.Name,
+				RuleID:     rule.Uid,
+				Permission: rule.Permission,
+				Deny:       rule.Deny,
+			})
+		}
+	}
+
+	b, err := json.Marshal(map[string]interface{}{"groupsWithAccessTo": grants})
+	return b, errors.Wrapf(err, "couldn't marshal groups with access to %s", gr.predicate)
+}
+
+type protectedPredicateResolver struct {
+	predicate string
+}
+
+func (pr *protectedPredicateResolver) Rewrite(q schema.Query) (*gql.GraphQuery, error) {
+	glog.Info("Got isPredicateProtected request through GraphQL admin API")
+
+	pr.predicate, _ = q.ArgValue("predicate").(string)
+	return groupRulesOnQuery(pr.predicate), nil
+}
+
+func (pr *protectedPredicateResolver) Query(
+	ctx context.Context, query *gql.GraphQuery) ([]byte, error) {
+
+	resp, err := resolve.DgraphAsQueryExecutor().Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var res struct {
+		Groups []aclGroup `json:"groups"`
+	}
+	if err := json.Unmarshal(resp, &res); err != nil {
+		return nil, errors.Wrapf(err, "couldn't unmarshal groups")
+	}
+
+	protected := false
+	for _, group := range res.Groups {
+		protected = protected || len(group.Rules) > 0
+	}
+
+	b, err := json.Marshal(map[string]interface{}{"isPredicateProtected": protected})
+	return b, errors.Wrapf(err, "couldn't marshal whether %s is protected", pr.predicate)
+}
+
+// readablePredicatesResolver resolves readablePredicates by reading the groups of the user and
+// checking the rules in the ACL cache, which are the rules the user's queries are checked
+// against.
+type readablePredicatesResolver struct {
+	user string
+}
+
+func (rr *readablePredicatesResolver) Rewrite(q schema.Query) (*gql.GraphQuery, error) {
+	glog.Info("Got readablePredicates request through GraphQL admin API")
+
+	rr.user, _ = q.ArgValue("user").(string)
+
+	return &gql.GraphQuery{
+		Attr: "user",
+		Func: &gql.Function{
+			Name: "eq",
+			Args: []gql.Arg{{Value: "dgraph.xid"}, {Value: fmt.Sprintf("%q", rr.user)}},
+		},
+		Filter: &gql.FilterTree{
+			Func: &gql.Function{
+				Name: "type",
+				Args: []gql.Arg{{Value: "User"}},
+			},
+		},
+		Children: []*gql.GraphQuery{
+			{Attr: "dgraph.xid"},
+			{
+				Attr:     "dgraph.user.group",
+				Children: []*gql.GraphQuery{{Attr: "dgraph.xid"}},
+			},
+		},
+	}, nil
+}
+
+func (rr *readablePredicatesResolver) Query(
+	ctx context.Context, query *gql.GraphQuery) ([]byte, error) {
+
+	resp, err := resolve.DgraphAsQueryExecutor().Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var res struct {
+		User []aclUser `json:"user"`
+	}
+	if err := json.Unmarshal(resp, &res); err != nil {
+		return nil, errors.Wrapf(err, "couldn't unmarshal user %s", rr.user)
+	}
+	if len(res.User) == 0 {
+		return []byte(`{"readablePredicates": null}`), nil
+	}
+
+	var groups []string
+	for _, group := range res.User[0].Groups {
+		groups = append(groups, group.Name)
+	}
+
+	nodes, err := worker.GetSchemaOverNetwork(ctx, &pb.SchemaRequest{})
+	if err != nil {
+		return nil, err
+	}
+	preds := []string{}
+	for _, node := range nodes {
+		preds = append(preds, node.Predicate)
+	}
+	if x.IsGuardian(groups) {
+		// Guardians can read every predicate in the schema.
+		sort.Strings(preds)
+	} else {
+		preds = edgraph.ReadablePredicates(rr.user, groups, preds)
+	}
+
+	b, err := json.Marshal(map[string]interface{}{"readablePredicates": preds})
+	return b, errors.Wrapf(err, "couldn't marshal readable predicates of user %s", rr.user)
+}
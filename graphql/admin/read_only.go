@@ -0,0 +1,83 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package admin
+
+import (
+	"context"
+
+	"github.com/dgraph-io/dgraph/graphql/resolve"
+	"github.com/dgraph-io/dgraph/graphql/schema"
+	"github.com/dgraph-io/dgraph/x"
+	"github.com/pkg/errors"
+)
+
+// readOnlyMutations are the mutations that are still resolved while --admin_read_only is set,
+// because they don't change anything in the cluster.
+var readOnlyMutations = map[string]bool{
+	"login": true,
+}
+
+// readOnlyResolverFactory is the ResolverFactory of the /admin endpoint. Every mutation resolver
+// added to it, except those in readOnlyMutations, rejects the mutation while --admin_read_only is
+// set, so that only queries and introspection are served.
+type readOnlyResolverFactory struct {
+	resolve.ResolverFactory
+}
+
+func (rf *readOnlyResolverFactory) WithQueryResolver(
+	name string, resolver func(schema.Query) resolve.QueryResolver) resolve.ResolverFactory {
+	rf.ResolverFactory.WithQueryResolver(name, resolver)
+	return rf
+}
+
+func (rf *readOnlyResolverFactory) WithMutationResolver(
+	name string, resolver func(schema.Mutation) resolve.MutationResolver) resolve.ResolverFactory {
+	if readOnlyMutations[name] {
+		rf.ResolverFactory.WithMutationResolver(name, resolver)
+		return rf
+	}
+	rf.ResolverFactory.WithMutationResolver(name, func(m schema.Mutation) resolve.MutationResolver {
+		return readOnlyMutation(resolver(m))
+	})
+	return rf
+}
+
+func (rf *readOnlyResolverFactory) WithConventionResolvers(
+	s schema.Schema, fns *resolve.ResolverFns) resolve.ResolverFactory {
+	rf.ResolverFactory.WithConventionResolvers(s, fns)
+	return rf
+}
+
+func (rf *readOnlyResolverFactory) WithSchemaIntrospection() resolve.ResolverFactory {
+	rf.ResolverFactory.WithSchemaIntrospection()
+	return rf
+}
+
+// readOnlyMutation wraps mr so that the mutation is rejected while --admin_read_only is set.
+func readOnlyMutation(mr resolve.MutationResolver) resolve.MutationResolver {
+	return resolve.MutationResolverFunc(
+		func(ctx context.Context, m schema.Mutation) (*resolve.Resolved, bool) {
+			if x.WorkerConfig.AdminReadOnly {
+				return &resolve.Resolved{
+					Err: schema.GQLWrapLocationf(
+						errors.Errorf("the admin endpoint is in read-only mode"),
+						m.Location(), "%s failed", m.Name()),
+				}, false
+			}
+			return mr.Resolve(ctx, m)
+		})
+}
@@ -0,0 +1,101 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package admin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dgraph-io/dgraph/graphql/resolve"
+	"github.com/dgraph-io/dgraph/graphql/schema"
+	"github.com/dgraph-io/dgraph/x"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdminReadOnly(t *testing.T) {
+	oldConfig := x.WorkerConfig
+	defer func() {
+		x.WorkerConfig = oldConfig
+	}()
+
+	adminSchema, err := schema.FromString(graphqlAdminSchema)
+	require.NoError(t, err)
+
+	// The schema updates are the mutations that read-only mode most needs to stop.
+	updates := map[string]string{
+		"updateGQLSchema": `mutation {
+			updateGQLSchema(input: { set: { schema: "type Author { name: String }" } }) {
+				gqlSchema { id }
+			}
+		}`,
+		"updateGQLSchemaFromURL": `mutation {
+			updateGQLSchemaFromURL(url: "http://schemas.example.com/schema.graphql") {
+				gqlSchema { id }
+			}
+		}`,
+		"updateGQLAndDgraphSchema": `mutation {
+			updateGQLAndDgraphSchema(input: {
+				set: { schema: "type Author { name: String }" }
+				dgraphSchema: "age: int ."
+			}) {
+				gqlSchema { id }
+			}
+		}`,
+	}
+
+	updated := make(map[string]bool)
+	rf := (&readOnlyResolverFactory{resolverFactoryWithErrorMsg(errResolverNotFound)}).
+		WithQueryResolver("health", func(q schema.Query) resolve.QueryResolver {
+			return resolve.QueryResolverFunc(
+				func(ctx context.Context, q schema.Query) *resolve.Resolved {
+					return &resolve.Resolved{Data: []byte(`"health": []`)}
+				})
+		}).
+		WithSchemaIntrospection()
+	for name := range updates {
+		rf = rf.WithMutationResolver(name, func(m schema.Mutation) resolve.MutationResolver {
+			return resolve.MutationResolverFunc(
+				func(ctx context.Context, m schema.Mutation) (*resolve.Resolved, bool) {
+					updated[m.Name()] = true
+					return &resolve.Resolved{Data: []byte(`"` + m.Name() + `": null`)}, true
+				})
+		})
+	}
+	resolver := resolve.New(adminSchema, rf)
+
+	x.WorkerConfig.AdminReadOnly = true
+	resp := resolver.Resolve(context.Background(), &schema.Request{
+		Query: `{ health { status } __schema { queryType { name } } }`,
+	})
+	require.Nil(t, resp.Errors)
+	require.Contains(t, resp.Data.String(), `"health"`)
+	require.Contains(t, resp.Data.String(), `"queryType"`)
+
+	for name, update := range updates {
+		resp = resolver.Resolve(context.Background(), &schema.Request{Query: update})
+		require.Len(t, resp.Errors, 1, name)
+		require.Contains(t, resp.Errors[0].Message, "the admin endpoint is in read-only mode")
+		require.False(t, updated[name], name)
+	}
+
+	x.WorkerConfig.AdminReadOnly = false
+	for name, update := range updates {
+		resp = resolver.Resolve(context.Background(), &schema.Request{Query: update})
+		require.Nil(t, resp.Errors, name)
+		require.True(t, updated[name], name)
+	}
+}
@@ -0,0 +1,54 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package admin
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/dgraph-io/dgraph/gql"
+	"github.com/dgraph-io/dgraph/graphql/schema"
+	"github.com/dgraph-io/dgraph/x"
+	"github.com/pkg/errors"
+)
+
+type reservedPredicate struct {
+	Name string `json:"name"`
+	// Modifiable is always false, as Alter rejects any change to the schema of a reserved
+	// predicate, and dropping it. It's returned so that clients don't need to hardcode that.
+	Modifiable bool `json:"modifiable"`
+}
+
+type reservedPredicatesResolver struct {
+}
+
+func (rr *reservedPredicatesResolver) Rewrite(q schema.Query) (*gql.GraphQuery, error) {
+	return nil, nil
+}
+
+func (rr *reservedPredicatesResolver) Query(ctx context.Context,
+	query *gql.GraphQuery) ([]byte, error) {
+
+	preds := x.AllReservedPredicates()
+	reserved := make([]reservedPredicate, 0, len(preds))
+	for _, pred := range preds {
+		reserved = append(reserved, reservedPredicate{Name: pred})
+	}
+
+	b, err := json.Marshal(map[string]interface{}{"reservedPredicates": reserved})
+	return b, errors.Wrapf(err, "couldn't marshal reserved predicates")
+}
@@ -0,0 +1,41 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReservedPredicates(t *testing.T) {
+	b, err := (&reservedPredicatesResolver{}).Query(context.Background(), nil)
+	require.NoError(t, err)
+
+	var resp struct {
+		ReservedPredicates []reservedPredicate `json:"reservedPredicates"`
+	}
+	require.NoError(t, json.Unmarshal(b, &resp))
+	require.Contains(t, resp.ReservedPredicates,
+		reservedPredicate{Name: "dgraph.xid", Modifiable: false})
+	require.Contains(t, resp.ReservedPredicates,
+		reservedPredicate{Name: "dgraph.type", Modifiable: false})
+	require.Contains(t, resp.ReservedPredicates,
+		reservedPredicate{Name: "dgraph.graphql.schema", Modifiable: false})
+}
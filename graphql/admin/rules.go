@@ -0,0 +1,645 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	dgoapi "github.com/dgraph-io/dgo/v2/protos/api"
+	"github.com/dgraph-io/dgraph/edgraph"
+	"github.com/dgraph-io/dgraph/gql"
+	"github.com/dgraph-io/dgraph/graphql/resolve"
+	"github.com/dgraph-io/dgraph/graphql/schema"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/worker"
+	"github.com/pkg/errors"
+)
+
+const (
+	// groupQueryVar is the variable the upsert queries below assign the matched group to.
+	groupQueryVar = "x"
+
+	// maxPermission is the permission that grants Read, Write and Modify.
+	maxPermission = 7
+)
+
+// validateRule checks that rule, which is a RuleRef from the input of a mutation, can be stored.
+// Rules that refer to an existing rule by id are only checked for the fields they set.
+func validateRule(rule map[string]interface{}) error {
+	_, hasID := rule["id"]
+	predicate, hasPredicate := rule["predicate"]
+	if pred, _ := predicate.(string); (!hasID || hasPredicate) && pred == "" {
+		return errors.Errorf("predicate of a rule can't be empty")
+	}
+
+	permission, hasPermission := rule["permission"]
+	if !hasID && !hasPermission {
+		return errors.Errorf("permission of the rule for %v is required", predicate)
+	}
+	if hasPermission {
+		perm, err := ruleNumber(permission)
+		if err != nil {
+			return errors.Wrapf(err, "permission of the rule for %v", predicate)
+		}
+		if perm < 0 || perm > maxPermission {
+			return errors.Errorf("permission of the rule for %v must be between 0 and %d, "+
+				"but got %d", predicate, maxPermission, perm)
+		}
+	}
+	if filter, _ := rule["filter"].(string); filter != "" {
+		if _, err := gql.ParseFilter(filter); err != nil {
+			return errors.Wrapf(err, "filter of the rule for %v", predicate)
+		}
+	}
+	return nil
+}
+
+// validateDefaultPermission checks that perm, which is the defaultPermission of a group from the
+// input of a mutation, is a valid permission.
+func validateDefaultPermission(perm interface{}) error {
+	if perm == nil {
+		return nil
+	}
+	n, err := ruleNumber(perm)
+	if err != nil {
+		return errors.Wrapf(err, "default permission")
+	}
+	if n < 0 || n > maxPermission {
+		return errors.Errorf("default permission must be between 0 and %d, but got %d",
+			maxPermission, n)
+	}
+	return nil
+}
+
+// ruleNumber converts an Int argument, which is decoded from either the query or the variables
+// of the request, to an int64.
+func ruleNumber(val interface{}) (int64, error) {
+	switch val := val.(type) {
+	case int64:
+		return val, nil
+	case float64:
+		return int64(val), nil
+	case json.Number:
+		return val.Int64()
+	case int:
+		return int64(val), nil
+	default:
+		return 0, errors.Errorf("%v isn't an integer", val)
+	}
+}
+
+// schemaPredicates returns those of preds that are in the schema.
+var schemaPredicates = func(ctx context.Context, preds []string) (map[string]bool, error) {
+	nodes, err := worker.GetSchemaOverNetwork(ctx, &pb.SchemaRequest{Predicates: preds})
+	if err != nil {
+		return nil, err
+	}
+	found := make(map[string]bool)
+	for _, node := range nodes {
+		found[node.Predicate] = true
+	}
+	return found, nil
+}
+
+// validateRulePredicates returns an error listing the predicates of rules that aren't in the
+// schema, if worker.Config.AclStrictRules is set.
+func validateRulePredicates(ctx context.Context, rules []interface{}) error {
+	if !worker.Config.AclStrictRules {
+		return nil
+	}
+
+	var preds []string
+	for _, r := range rules {
+		rule, _ := r.(map[string]interface{})
+		if pred, ok := rule["predicate"].(string); ok {
+			preds = append(preds, pred)
+		}
+	}
+	if len(preds) == 0 {
+		return nil
+	}
+
+	found, err := schemaPredicates(ctx, preds)
+	if err != nil {
+		return err
+	}
+	var unknown []string
+	for _, pred := range preds {
+		if !found[pred] {
+			unknown = append(unknown, pred)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return errors.Errorf("rules can't be added for predicates that aren't in the schema: %s",
+			strings.Join(unknown, ", "))
+	}
+	return nil
+}
+
+// validateRules validates a list of RuleRef.
+func validateRules(ctx context.Context, rules []interface{}) error {
+	for _, r := range rules {
+		rule, _ := r.(map[string]interface{})
+		if err := validateRule(rule); err != nil {
+			return err
+		}
+	}
+	return validateRulePredicates(ctx, rules)
+}
+
+// validateGroupRules wraps the addGroup or updateGroup resolver mr so that the names, rules and
+// default permissions the groups are created with, or the ones set on the group, are validated
+// before they are stored.
+func validateGroupRules(mr resolve.MutationResolver) resolve.MutationResolver {
+	return resolve.MutationResolverFunc(
+		func(ctx context.Context, m schema.Mutation) (*resolve.Resolved, bool) {
+			var rules, defaults []interface{}
+			var err error
+			switch m.Name() {
+			case "addGroup":
+				inputs, _ := m.ArgValue(schema.InputArgName).([]interface{})
+				for _, i := range inputs {
+					input, _ := i.(map[string]interface{})
+					if name, _ := input["name"].(string); err == nil {
+						err = edgraph.ValidateGroupName(name)
+					}
+					groupRules, _ := input["rules"].([]interface{})
+					rules = append(rules, groupRules...)
+					defaults = append(defaults, input["defaultPermission"])
+				}
+			case "updateGroup":
+				input, _ := m.ArgValue(schema.InputArgName).(map[string]interface{})
+				set, _ := input["set"].(map[string]interface{})
+				rules, _ = set["rules"].([]interface{})
+				defaults = append(defaults, set["defaultPermission"])
+			}
+			for _, perm := range defaults {
+				if err == nil {
+					err = validateDefaultPermission(perm)
+				}
+			}
+			if err == nil {
+				err = validateRules(ctx, rules)
+			}
+			if err != nil {
+				return &resolve.Resolved{
+					Err: schema.GQLWrapLocationf(err, m.Location(), "%s failed", m.Name()),
+				}, false
+			}
+			return mr.Resolve(ctx, m)
+		})
+}
+
+// groupRules returns the rules of the groups with the given names, mapped by group name. Groups
+// that don't exist are not in the result.
+func groupRules(ctx context.Context, names ...string) (map[string][]aclRule, error) {
+	return nodeRules(ctx, "Group", names...)
+}
+
+// nodeRules returns the rules of the nodes of type typ, which is Group or User, with the given
+// names, mapped by name. Nodes that don't exist are not in the result.
+func nodeRules(ctx context.Context, typ string, names ...string) (map[string][]aclRule, error) {
+	query := &gql.GraphQuery{}
+	for i, name := range names {
+		query.Children = append(query.Children, &gql.GraphQuery{
+			Attr: fmt.Sprintf("node%d", i),
+			Func: &gql.Function{
+				Name: "eq",
+				Args: []gql.Arg{{Value: "dgraph.xid"}, {Value: fmt.Sprintf("%q", name)}},
+			},
+			Filter: &gql.FilterTree{
+				Func: &gql.Function{
+					Name: "type",
+					Args: []gql.Arg{{Value: typ}},
+				},
+			},
+			Children: []*gql.GraphQuery{
+				{Attr: "dgraph.xid"},
+				{
+					Attr: "dgraph.acl.rule",
+					Children: []*gql.GraphQuery{
+						{Attr: "uid"},
+						{Attr: "dgraph.rule.predicate"},
+						{Attr: "dgraph.rule.permission"},
+						{Attr: "dgraph.rule.deny"},
+						{Attr: "dgraph.rule.filter"},
+					},
+				},
+			},
+		})
+	}
+
+	resp, err := resolve.AdminQueryExecutor().Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	// Users are unmarshalled like groups, as only their names and rules are queried.
+	var res map[string][]aclGroup
+	if err := json.Unmarshal(resp, &res); err != nil {
+		return nil, errors.Wrapf(err, "couldn't unmarshal %s nodes", typ)
+	}
+
+	rules := make(map[string][]aclRule)
+	for _, nodes := range res {
+		for _, node := range nodes {
+			rules[node.Name] = node.Rules
+		}
+	}
+	return rules, nil
+}
+
+// groupUpsertQuery builds an upsert query that finds the group with the given name, assigns it
+// to groupQueryVar and returns its uid in a block named after the mutation.
+func groupUpsertQuery(m schema.Mutation, name string) *gql.GraphQuery {
+	return &gql.GraphQuery{
+		Children: []*gql.GraphQuery{{
+			Var:  groupQueryVar,
+			Attr: m.ResponseName(),
+			Func: &gql.Function{
+				Name: "eq",
+				Args: []gql.Arg{{Value: "dgraph.xid"}, {Value: fmt.Sprintf("%q", name)}},
+			},
+			Filter: &gql.FilterTree{
+				Func: &gql.Function{
+					Name: "type",
+					Args: []gql.Arg{{Value: "Group"}},
+				},
+			},
+			Children: []*gql.GraphQuery{{Attr: "uid"}},
+		}},
+	}
+}
+
+// newRuleJSON returns the JSON for a new rule node, to be set on a group in a mutation.
+func newRuleJSON(blankNode string, rule aclRule) map[string]interface{} {
+	ruleJSON := map[string]interface{}{
+		"uid":                    "_:" + blankNode,
+		"dgraph.type":            "Rule",
+		"dgraph.rule.predicate":  rule.Predicate,
+		"dgraph.rule.permission": rule.Permission,
+		"dgraph.rule.deny":       rule.Deny,
+	}
+	if rule.Filter != "" {
+		ruleJSON["dgraph.rule.filter"] = rule.Filter
+	}
+	return ruleJSON
+}
+
+// precomputedRewriter is a MutationRewriter for mutations whose upsert query and Dgraph
+// mutations are worked out before the mutation is resolved, because that needs to read the
+// current ACL data.
+type precomputedRewriter struct {
+	query     *gql.GraphQuery
+	mutations []*dgoapi.Mutation
+}
+
+func (pr *precomputedRewriter) Rewrite(
+	m schema.Mutation) (*gql.GraphQuery, []*dgoapi.Mutation, error) {
+	return pr.query, pr.mutations, nil
+}
+
+func (pr *precomputedRewriter) FromMutationResult(
+	mutation schema.Mutation,
+	assigned map[string]string,
+	result map[string]interface{}) (*gql.GraphQuery, error) {
+
+	// The upsert query is named after the mutation, so the update rewriter can build the query
+	// that returns the mutated node.
+	return resolve.NewUpdateRewriter().FromMutationResult(mutation, assigned, result)
+}
+
+// resolveGroupRules resolves m by setting the rules in set on the group with the given name and
+// removing the rules in del from it, in a single upsert. The rules are in the JSON format that
+// Dgraph mutations take.
+func resolveGroupRules(ctx context.Context, m schema.Mutation, group string,
+	set, del []interface{}) (*resolve.Resolved, bool) {
+	return resolveRules(ctx, m, groupUpsertQuery(m, group), set, del)
+}
+
+// resolveRules is like resolveGroupRules, for the group or user that upsertQuery assigns to
+// groupQueryVar, which is also the userQueryVar of userUpsertQuery.
+func resolveRules(ctx context.Context, m schema.Mutation, upsertQuery *gql.GraphQuery,
+	set, del []interface{}) (*resolve.Resolved, bool) {
+
+	target := fmt.Sprintf("uid(%s)", groupQueryVar)
+	mutation := &dgoapi.Mutation{}
+	var err error
+	if len(set) > 0 {
+		if mutation.SetJson, err = json.Marshal(map[string]interface{}{
+			"uid":             target,
+			"dgraph.acl.rule": set,
+		}); err != nil {
+			return failedMutation(m, err)
+		}
+	}
+	if len(del) > 0 {
+		if mutation.DeleteJson, err = json.Marshal(map[string]interface{}{
+			"uid":             target,
+			"dgraph.acl.rule": del,
+		}); err != nil {
+			return failedMutation(m, err)
+		}
+	}
+	var mutations []*dgoapi.Mutation
+	if len(set) > 0 || len(del) > 0 {
+		mutations = append(mutations, mutation)
+	}
+
+	return resolve.NewMutationResolver(
+		&precomputedRewriter{query: upsertQuery, mutations: mutations},
+		resolve.DgraphAsQueryExecutor(),
+		resolve.DgraphAsMutationExecutor(),
+		resolve.StdMutationCompletion(m.Name())).Resolve(ctx, m)
+}
+
+// rulesByPredicate converts the RuleRefs in input, which have been validated, to rules that are
+// matched to the existing rules of a group by predicate. So, they can't have an id and there
+// can only be one rule for a predicate.
+func rulesByPredicate(input []interface{}) ([]aclRule, error) {
+	rules := make([]aclRule, 0, len(input))
+	seen := make(map[string]bool)
+	for _, r := range input {
+		ruleRef, _ := r.(map[string]interface{})
+		if _, ok := ruleRef["id"]; ok {
+			return nil, errors.Errorf("rules are matched by predicate, so the rule for %v "+
+				"can't have an id", ruleRef["predicate"])
+		}
+		// validateRules has checked the predicate and permission of every rule.
+		perm, _ := ruleNumber(ruleRef["permission"])
+		deny, _ := ruleRef["deny"].(bool)
+		filter, _ := ruleRef["filter"].(string)
+		rule := aclRule{
+			Predicate:  ruleRef["predicate"].(string),
+			Permission: int32(perm),
+			Deny:       deny,
+			Filter:     filter,
+		}
+		if seen[rule.Predicate] {
+			return nil, errors.Errorf("more than one rule for %s", rule.Predicate)
+		}
+		seen[rule.Predicate] = true
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+func failedMutation(m schema.Mutation, err error) (*resolve.Resolved, bool) {
+	return &resolve.Resolved{
+		Err: schema.GQLWrapLocationf(err, m.Location(), "%s failed", m.Name()),
+	}, false
+}
+
+// copyGroupRules resolves the copyGroupRules mutation, which copies the rules of one group to
+// another. A rule of the source group replaces the rule for the same predicate in the target
+// group if overwrite is set, and is skipped otherwise.
+func copyGroupRules(ctx context.Context, m schema.Mutation) (*resolve.Resolved, bool) {
+	from, _ := m.ArgValue("from").(string)
+	to, _ := m.ArgValue("to").(string)
+	overwrite, _ := m.ArgValue("overwrite").(bool)
+
+	rules, err := groupRules(ctx, from, to)
+	if err != nil {
+		return failedMutation(m, err)
+	}
+	sourceRules, ok := rules[from]
+	if !ok {
+		return failedMutation(m, errors.Errorf("group %s doesn't exist", from))
+	}
+	targetRules, ok := rules[to]
+	if !ok {
+		return failedMutation(m, errors.Errorf("group %s doesn't exist", to))
+	}
+
+	existing := make(map[string]aclRule)
+	for _, rule := range targetRules {
+		existing[rule.Predicate] = rule
+	}
+
+	var newRules, oldRules, copied []interface{}
+	for i, rule := range sourceRules {
+		if old, ok := existing[rule.Predicate]; ok {
+			if !overwrite {
+				continue
+			}
+			oldRules = append(oldRules, map[string]interface{}{"uid": old.Uid})
+		}
+		newRules = append(newRules, newRuleJSON(fmt.Sprintf("rule%d", i), rule))
+		copied = append(copied, map[string]interface{}{
+			"predicate":  rule.Predicate,
+			"permission": int64(rule.Permission),
+		})
+	}
+	if err := validateRules(ctx, copied); err != nil {
+		return failedMutation(m, err)
+	}
+
+	return resolveGroupRules(ctx, m, to, newRules, oldRules)
+}
+
+// setGroupRules resolves the setGroupRules mutation, which makes the rules of a group exactly the
+// given rules. Rules are matched to the existing rules of the group by predicate: the existing
+// rule is updated if its permission, deny or filter differs, rules for new predicates are added
+// and the rules for the predicates that aren't given are removed.
+func setGroupRules(ctx context.Context, m schema.Mutation) (*resolve.Resolved, bool) {
+	name, _ := m.ArgValue("name").(string)
+	return setRules(ctx, m, "Group", name, groupUpsertQuery(m, name))
+}
+
+// setUserRules resolves the setUserRules mutation, which makes the rules set directly on a user
+// exactly the given rules, like setGroupRules does for a group. The rules of the user apply to
+// it in addition to the rules of its groups.
+func setUserRules(ctx context.Context, m schema.Mutation) (*resolve.Resolved, bool) {
+	name, _ := m.ArgValue("name").(string)
+	name, err := edgraph.ResolveUserId(ctx, name)
+	if err != nil {
+		return failedMutation(m, err)
+	}
+	return setRules(ctx, m, "User", name, userUpsertQuery(m, name))
+}
+
+// setRules makes the given rules the rules of the node of type typ with the given name, which
+// upsertQuery finds.
+func setRules(ctx context.Context, m schema.Mutation, typ, name string,
+	upsertQuery *gql.GraphQuery) (*resolve.Resolved, bool) {
+	input, _ := m.ArgValue("rules").([]interface{})
+
+	if err := validateRules(ctx, input); err != nil {
+		return failedMutation(m, err)
+	}
+
+	rules, err := nodeRules(ctx, typ, name)
+	if err != nil {
+		return failedMutation(m, err)
+	}
+	currentRules, ok := rules[name]
+	if !ok {
+		return failedMutation(m, errors.Errorf("%s %s doesn't exist", strings.ToLower(typ), name))
+	}
+	existing := make(map[string]aclRule)
+	for _, rule := range currentRules {
+		existing[rule.Predicate] = rule
+	}
+
+	newRules, err := rulesByPredicate(input)
+	if err != nil {
+		return failedMutation(m, err)
+	}
+
+	var set, del []interface{}
+	wanted := make(map[string]bool)
+	for i, rule := range newRules {
+		wanted[rule.Predicate] = true
+
+		old, ok := existing[rule.Predicate]
+		switch {
+		case !ok:
+			set = append(set, newRuleJSON(fmt.Sprintf("rule%d", i), rule))
+		case old.Permission != rule.Permission || old.Deny != rule.Deny ||
+			old.Filter != rule.Filter:
+			set = append(set, map[string]interface{}{
+				"uid":                    old.Uid,
+				"dgraph.rule.permission": rule.Permission,
+				"dgraph.rule.deny":       rule.Deny,
+				"dgraph.rule.filter":     rule.Filter,
+			})
+		}
+	}
+	for _, rule := range currentRules {
+		if !wanted[rule.Predicate] {
+			del = append(del, map[string]interface{}{"uid": rule.Uid})
+		}
+	}
+
+	return resolveRules(ctx, m, upsertQuery, set, del)
+}
+
+// addRulesToGroups resolves the addRulesToGroups mutation, which adds the same rules to several
+// groups in a single transaction. Each group gets its own copy of the rules, which replace the
+// rules of the group for the same predicates. If one of the groups doesn't exist, no group is
+// changed.
+func addRulesToGroups(ctx context.Context, m schema.Mutation) (*resolve.Resolved, bool) {
+	namesArg, _ := m.ArgValue("names").([]interface{})
+	input, _ := m.ArgValue("rules").([]interface{})
+
+	if len(namesArg) == 0 || len(input) == 0 {
+		return failedMutation(m, errors.Errorf("both names and rules must be given"))
+	}
+	if err := validateRules(ctx, input); err != nil {
+		return failedMutation(m, err)
+	}
+	newRules, err := rulesByPredicate(input)
+	if err != nil {
+		return failedMutation(m, err)
+	}
+
+	var names []string
+	seen := make(map[string]bool)
+	for _, n := range namesArg {
+		if name, _ := n.(string); !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	rules, err := groupRules(ctx, names...)
+	if err != nil {
+		return failedMutation(m, err)
+	}
+	var missing []string
+	for _, name := range names {
+		if _, ok := rules[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		return failedMutation(m, errors.Errorf("groups don't exist: %s",
+			strings.Join(missing, ", ")))
+	}
+
+	namesJSON, err := json.Marshal(names)
+	if err != nil {
+		return failedMutation(m, err)
+	}
+	// The groups are returned by a block named after the mutation, so that the mutated groups
+	// can be queried like for updateGroup. Every group is also assigned to a variable of its
+	// own in a var block, which its mutation is applied to.
+	query := &gql.GraphQuery{
+		Children: []*gql.GraphQuery{{
+			Attr: m.ResponseName(),
+			Func: &gql.Function{
+				Name: "eq",
+				Args: []gql.Arg{{Value: "dgraph.xid"}, {Value: string(namesJSON)}},
+			},
+			Filter: &gql.FilterTree{
+				Func: &gql.Function{
+					Name: "type",
+					Args: []gql.Arg{{Value: "Group"}},
+				},
+			},
+			Children: []*gql.GraphQuery{{Attr: "uid"}},
+		}},
+	}
+
+	var mutations []*dgoapi.Mutation
+	for i, name := range names {
+		groupVar := fmt.Sprintf("group%d", i)
+		groupQuery := groupUpsertQuery(m, name).Children[0]
+		groupQuery.Var, groupQuery.Attr = groupVar, "var"
+		query.Children = append(query.Children, groupQuery)
+
+		existing := make(map[string]aclRule)
+		for _, rule := range rules[name] {
+			existing[rule.Predicate] = rule
+		}
+		var set, del []interface{}
+		for j, rule := range newRules {
+			if old, ok := existing[rule.Predicate]; ok {
+				del = append(del, map[string]interface{}{"uid": old.Uid})
+			}
+			set = append(set, newRuleJSON(fmt.Sprintf("%s_rule%d", groupVar, j), rule))
+		}
+
+		target := fmt.Sprintf("uid(%s)", groupVar)
+		mutation := &dgoapi.Mutation{}
+		if mutation.SetJson, err = json.Marshal(map[string]interface{}{
+			"uid":             target,
+			"dgraph.acl.rule": set,
+		}); err != nil {
+			return failedMutation(m, err)
+		}
+		if len(del) > 0 {
+			if mutation.DeleteJson, err = json.Marshal(map[string]interface{}{
+				"uid":             target,
+				"dgraph.acl.rule": del,
+			}); err != nil {
+				return failedMutation(m, err)
+			}
+		}
+		mutations = append(mutations, mutation)
+	}
+
+	return resolve.NewMutationResolver(
+		&precomputedRewriter{query: query, mutations: mutations},
+		resolve.DgraphAsQueryExecutor(),
+		resolve.DgraphAsMutationExecutor(),
+		resolve.StdMutationCompletion(m.Name())).Resolve(ctx, m)
+}
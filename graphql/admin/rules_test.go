@@ -0,0 +1,105 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package admin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dgraph-io/dgraph/worker"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateRule(t *testing.T) {
+	require.NoError(t, validateRule(map[string]interface{}{"predicate": "name", "permission": 4}))
+	require.NoError(t, validateRule(map[string]interface{}{"id": "0x1", "permission": 0}))
+	require.Error(t, validateRule(map[string]interface{}{"predicate": "", "permission": 4}))
+	require.Error(t, validateRule(map[string]interface{}{"predicate": "name"}))
+	require.Error(t, validateRule(map[string]interface{}{"predicate": "name", "permission": 8}))
+	require.Error(t, validateRule(map[string]interface{}{"predicate": "name", "permission": -1}))
+}
+
+func TestValidateDefaultPermission(t *testing.T) {
+	require.NoError(t, validateDefaultPermission(nil))
+	require.NoError(t, validateDefaultPermission(int64(4)))
+	require.Error(t, validateDefaultPermission(int64(8)))
+	require.Error(t, validateDefaultPermission("read"))
+}
+
+func TestValidateRulePredicates(t *testing.T) {
+	oldConfig, oldSchemaPredicates := worker.Config, schemaPredicates
+	defer func() {
+		worker.Config, schemaPredicates = oldConfig, oldSchemaPredicates
+	}()
+	schemaPredicates = func(ctx context.Context, preds []string) (map[string]bool, error) {
+		return map[string]bool{"name": true}, nil
+	}
+
+	rules := []interface{}{
+		map[string]interface{}{"predicate": "name", "permission": 4},
+		map[string]interface{}{"predicate": "nmae", "permission": 4},
+	}
+
+	worker.Config.AclStrictRules = false
+	require.NoError(t, validateRules(context.Background(), rules))
+
+	worker.Config.AclStrictRules = true
+	require.EqualError(t, validateRules(context.Background(), rules),
+		"rules can't be added for predicates that aren't in the schema: nmae")
+	require.NoError(t, validateRules(context.Background(), rules[:1]))
+}
+
+func TestValidateGroup(t *testing.T) {
+	oldSchemaPredicates := schemaPredicates
+	defer func() {
+		schemaPredicates = oldSchemaPredicates
+	}()
+	schemaPredicates = func(ctx context.Context, preds []string) (map[string]bool, error) {
+		return map[string]bool{"name": true, "age": true}, nil
+	}
+
+	clean := &groupWithMembers{
+		Name:    "dev",
+		Rules:   []aclRule{{Predicate: "name"}, {Predicate: "age"}},
+		Members: []groupMember{{Uid: "0x1", Name: "alice", Types: []string{"User"}}},
+	}
+	validation, err := validateGroup(context.Background(), clean)
+	require.NoError(t, err)
+	require.Equal(t, &groupValidation{
+		Name:           "dev",
+		Valid:          true,
+		DanglingRules:  []string{},
+		MissingMembers: []string{},
+	}, validation)
+
+	dangling := &groupWithMembers{
+		Name:  "ops",
+		Rules: []aclRule{{Predicate: "name"}, {Predicate: "nmae"}},
+		Members: []groupMember{
+			{Uid: "0x1", Name: "alice", Types: []string{"User"}},
+			{Uid: "0x2"},
+		},
+	}
+	validation, err = validateGroup(context.Background(), dangling)
+	require.NoError(t, err)
+	require.Equal(t, &groupValidation{
+		Name:           "ops",
+		Valid:          false,
+		DanglingRules:  []string{"nmae"},
+		MissingMembers: []string{"0x2"},
+	}, validation)
+}
@@ -20,14 +20,19 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/golang/glog"
+	"github.com/pkg/errors"
 
 	dgoapi "github.com/dgraph-io/dgo/v2/protos/api"
 	"github.com/dgraph-io/dgraph/edgraph"
 	"github.com/dgraph-io/dgraph/gql"
 	"github.com/dgraph-io/dgraph/graphql/resolve"
 	"github.com/dgraph-io/dgraph/graphql/schema"
+	dschema "github.com/dgraph-io/dgraph/schema"
 	"github.com/dgraph-io/dgraph/x"
 )
 
@@ -43,6 +48,19 @@ type updateSchemaResolver struct {
 	newDgraphSchema string
 	newSchema       gqlSchema
 
+	// diff is the change to the Dgraph schema made by the update
+	diff *schemaDiff
+	// force applies the update even if it isn't backward compatible
+	force bool
+	// input reads the update from the mutation
+	input func(m schema.Mutation) (*updateGQLSchemaInput, error)
+	// restoreOnFailure tries to restore the previous GraphQL schema if the Dgraph schema can't
+	// be altered. It's best-effort: if the restore fails, or Alpha stops between saving the
+	// GraphQL schema and altering the Dgraph schema, the two are left out of step.
+	restoreOnFailure bool
+	// dgraphSchema is applied along with the Dgraph schema generated for the GraphQL schema
+	dgraphSchema string
+
 	// The underlying executor and rewriter that persist the schema into Dgraph as
 	// GraphQL metadata
 	baseAddRewriter      resolve.MutationRewriter
@@ -59,20 +77,23 @@ type getSchemaResolver struct {
 }
 
 type updateGQLSchemaInput struct {
-	Set gqlSchema `json:"set,omitempty"`
+	Set          gqlSchema `json:"set,omitempty"`
+	Force        bool      `json:"force,omitempty"`
+	DgraphSchema string    `json:"dgraphSchema,omitempty"`
 }
 
 func (asr *updateSchemaResolver) Rewrite(
 	m schema.Mutation) (*gql.GraphQuery, []*dgoapi.Mutation, error) {
 
-	glog.Info("Got updateGQLSchema request")
+	glog.Infof("Got %s request", m.Name())
 
-	input, err := getSchemaInput(m)
+	input, err := asr.input(m)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	asr.newSchema.Schema = input.Set.Schema
+	asr.force = input.Force
 	schHandler, err := schema.NewHandler(asr.newSchema.Schema)
 	if err != nil {
 		return nil, nil, err
@@ -85,11 +106,24 @@ func (asr *updateSchemaResolver) Rewrite(
 	}
 
 	asr.newDgraphSchema = schHandler.DGSchema()
+	if input.DgraphSchema != "" {
+		// The schema that's altered is checked here, so that one that can't be parsed fails
+		// the update before anything is stored.
+		if _, err := dschema.Parse(
+			asr.newDgraphSchema + "\n" + input.DgraphSchema); err != nil {
+			return nil, nil, schema.GQLWrapf(err, "couldn't parse the Dgraph schema")
+		}
+		asr.dgraphSchema = input.DgraphSchema
+	}
+	asr.newSchema.UpdatedAt = time.Now().UTC().Format(time.RFC3339)
 
 	if asr.admin.schema.ID == "" {
 		// There's never been a GraphQL schema in this Dgraph before so rewrite this into
 		// an add
-		m.SetArgTo(schema.InputArgName, map[string]interface{}{"schema": asr.newSchema.Schema})
+		m.SetArgTo(schema.InputArgName, map[string]interface{}{
+			"schema":    asr.newSchema.Schema,
+			"updatedAt": asr.newSchema.UpdatedAt,
+		})
 		return asr.baseAddRewriter.Rewrite(m)
 	}
 
@@ -97,7 +131,10 @@ func (asr *updateSchemaResolver) Rewrite(
 	m.SetArgTo(schema.InputArgName,
 		map[string]interface{}{
 			"filter": map[string]interface{}{"ids": []interface{}{asr.admin.schema.ID}},
-			"set":    map[string]interface{}{"schema": asr.newSchema.Schema},
+			"set": map[string]interface{}{
+				"schema":    asr.newSchema.Schema,
+				"updatedAt": asr.newSchema.UpdatedAt,
+			},
 		})
 	return asr.baseMutationRewriter.Rewrite(m)
 }
@@ -119,6 +156,24 @@ func (asr *updateSchemaResolver) Mutate(
 	asr.admin.mux.Lock()
 	defer asr.admin.mux.Unlock()
 
+	var oldDgraphSchema string
+	if asr.admin.schema.ID != "" {
+		schHandler, err := schema.NewHandler(asr.admin.schema.Schema)
+		if err != nil {
+			return nil, nil, err
+		}
+		oldDgraphSchema = schHandler.DGSchema()
+	}
+	diff, err := diffDgraphSchemas(oldDgraphSchema, asr.newDgraphSchema)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(diff.incompatible) > 0 && !asr.force {
+		return nil, nil, errors.Errorf("the schema update isn't backward compatible, "+
+			"set force to apply it anyway: %s", strings.Join(diff.incompatible, "; "))
+	}
+	asr.diff = diff
+
 	assigned, result, err := asr.baseMutationExecutor.Mutate(ctx, query, mutations)
 	if err != nil {
 		return nil, nil, err
@@ -133,7 +188,16 @@ func (asr *updateSchemaResolver) Mutate(
 		asr.newSchema.ID = asr.admin.schema.ID
 	}
 
-	_, err = (&edgraph.Server{}).Alter(ctx, &dgoapi.Operation{Schema: asr.newDgraphSchema})
+	_, err = (&edgraph.Server{}).Alter(ctx,
+		&dgoapi.Operation{Schema: asr.newDgraphSchema + "\n" + asr.dgraphSchema})
+	if err != nil && asr.restoreOnFailure {
+		if rbErr := asr.rollback(ctx); rbErr != nil {
+			return nil, nil, schema.GQLWrapf(err, "failed to alter Dgraph schema, "+
+				"and failed to restore the previous GraphQL schema: %s", rbErr)
+		}
+		return nil, nil, schema.GQLWrapf(err,
+			"failed to alter Dgraph schema, so the GraphQL schema wasn't updated")
+	}
 	if err != nil {
 		return nil, nil, schema.GQLWrapf(err,
 			"succeeded in saving GraphQL schema but failed to alter Dgraph schema ")
@@ -147,8 +211,75 @@ func (asr *updateSchemaResolver) Mutate(
 	return assigned, result, nil
 }
 
+// rollback restores the stored GraphQL schema to the one being served, after the update saved a
+// new one.
+func (asr *updateSchemaResolver) rollback(ctx context.Context) error {
+	old := asr.admin.schema
+	mu := &dgoapi.Mutation{}
+	if old.ID == "" {
+		// The update added the first GraphQL schema, so the node it's stored in is deleted.
+		mu.DelNquads = []byte(fmt.Sprintf("<%s> * * .", asr.newSchema.ID))
+	} else {
+		set := map[string]interface{}{
+			"uid":                   old.ID,
+			"dgraph.graphql.schema": old.Schema,
+		}
+		if old.UpdatedAt != "" {
+			set["dgraph.graphql.schema_updated_at"] = old.UpdatedAt
+		} else {
+			mu.DelNquads = []byte(fmt.Sprintf("<%s> <dgraph.graphql.schema_updated_at> * .",
+				old.ID))
+		}
+		var err error
+		if mu.SetJson, err = json.Marshal(set); err != nil {
+			return err
+		}
+	}
+	_, _, err := asr.baseMutationExecutor.Mutate(ctx, nil, []*dgoapi.Mutation{mu})
+	return err
+}
+
 func (asr *updateSchemaResolver) Query(ctx context.Context, query *gql.GraphQuery) ([]byte, error) {
-	return doQuery(asr.admin.schema, asr.mutation.SelectionSet()[0])
+	field := asr.mutation.QueryField()
+	if field.Name() == "diff" {
+		var buf bytes.Buffer
+		x.Check2(buf.WriteString(`{ "`))
+		x.Check2(buf.WriteString(field.ResponseName()))
+		x.Check2(buf.WriteString(`": [`))
+		x.Check2(buf.Write(asr.diff.toJSON(field)))
+		x.Check2(buf.WriteString("]}"))
+		return buf.Bytes(), nil
+	}
+	return doQuery(asr.admin.schema, field)
+}
+
+// withDiff adds the diff to the result completed by cf, if it's selected. cf only completes the
+// first field selected in the payload, so the diff is added here when it's selected after the
+// gqlSchema.
+func (asr *updateSchemaResolver) withDiff(cf resolve.CompletionFunc) resolve.CompletionFunc {
+	return resolve.CompletionFunc(func(
+		ctx context.Context, field schema.Field, result []byte, err error) ([]byte, error) {
+
+		res, err := cf(ctx, field, result, err)
+		if asr.diff == nil || asr.mutation == nil || !bytes.HasSuffix(res, []byte("}")) {
+			return res, err
+		}
+
+		for _, sel := range asr.mutation.SelectionSet() {
+			if sel.Name() != "diff" || sel.ResponseName() == field.ResponseName() {
+				continue
+			}
+			var buf bytes.Buffer
+			x.Check2(buf.Write(res[:len(res)-1]))
+			x.Check2(buf.WriteString(`, "`))
+			x.Check2(buf.WriteString(sel.ResponseName()))
+			x.Check2(buf.WriteString(`": `))
+			x.Check2(buf.Write(asr.diff.toJSON(sel)))
+			x.Check2(buf.WriteString("}"))
+			res = buf.Bytes()
+		}
+		return res, err
+	})
 }
 
 func (gsr *getSchemaResolver) Rewrite(gqlQuery schema.Query) (*gql.GraphQuery, error) {
@@ -186,6 +317,12 @@ func doQuery(gql gqlSchema, field schema.Field) ([]byte, error) {
 			val, err = json.Marshal(gql.Schema)
 		case "generatedSchema":
 			val, err = json.Marshal(gql.GeneratedSchema)
+		case "updatedAt":
+			// Schemas stored before the time of updates was stored don't have one.
+			val = []byte("null")
+			if gql.UpdatedAt != "" {
+				val, err = json.Marshal(gql.UpdatedAt)
+			}
 		}
 		x.Check2(val, err)
 
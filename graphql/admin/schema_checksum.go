@@ -0,0 +1,105 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+
+	"github.com/dgraph-io/dgraph/gql"
+	"github.com/dgraph-io/dgraph/graphql/schema"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+)
+
+type schemaChecksum struct {
+	Checksum             string `json:"checksum"`
+	GraphqlSchemaVersion string `json:"graphqlSchemaVersion"`
+	DgraphSchemaChecksum string `json:"dgraphSchemaChecksum"`
+}
+
+// schemaChecksumResolver resolves schemaChecksum, which identifies the GraphQL schema served by
+// this alpha together with the Dgraph schema, so that an alpha serving a different schema than
+// the rest of the cluster can be spotted by comparing checksums.
+type schemaChecksumResolver struct {
+	admin *adminServer
+}
+
+func (sr *schemaChecksumResolver) Rewrite(q schema.Query) (*gql.GraphQuery, error) {
+	glog.Info("Got schemaChecksum request through GraphQL admin API")
+	return nil, nil
+}
+
+func (sr *schemaChecksumResolver) Query(
+	ctx context.Context, query *gql.GraphQuery) ([]byte, error) {
+
+	sr.admin.mux.Lock()
+	served := sr.admin.schema
+	sr.admin.mux.Unlock()
+
+	dgSchema, err := queryDgraphSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+	dgChecksum, err := dgraphSchemaChecksum(dgSchema)
+	if err != nil {
+		return nil, err
+	}
+
+	gqlVersion := schemaVersion(served.Schema)
+	b, err := json.Marshal(map[string]interface{}{"schemaChecksum": &schemaChecksum{
+		Checksum:             schemaVersion(gqlVersion + "\n" + dgChecksum),
+		GraphqlSchemaVersion: gqlVersion,
+		DgraphSchemaChecksum: dgChecksum,
+	}})
+	return b, errors.Wrapf(err, "couldn't marshal the schema checksum")
+}
+
+// dgraphSchemaChecksum identifies the Dgraph schema sch. The predicates, types, tokenizers and
+// type fields are sorted first, so that the checksum doesn't depend on the order they're
+// returned in.
+func dgraphSchemaChecksum(sch *dgraphSchema) (string, error) {
+	sorted := dgraphSchema{
+		Schema: make([]dgraphPredicate, 0, len(sch.Schema)),
+		Types:  make([]dgraphType, 0, len(sch.Types)),
+	}
+	for _, pred := range sch.Schema {
+		pred.Tokenizer = append([]string{}, pred.Tokenizer...)
+		sort.Strings(pred.Tokenizer)
+		sorted.Schema = append(sorted.Schema, pred)
+	}
+	for _, typ := range sch.Types {
+		typ.Fields = append(typ.Fields[:0:0], typ.Fields...)
+		sort.Slice(typ.Fields, func(i, j int) bool {
+			return typ.Fields[i].Name < typ.Fields[j].Name
+		})
+		sorted.Types = append(sorted.Types, typ)
+	}
+	sort.Slice(sorted.Schema, func(i, j int) bool {
+		return sorted.Schema[i].Predicate < sorted.Schema[j].Predicate
+	})
+	sort.Slice(sorted.Types, func(i, j int) bool {
+		return sorted.Types[i].Name < sorted.Types[j].Name
+	})
+
+	b, err := json.Marshal(sorted)
+	if err != nil {
+		return "", errors.Wrapf(err, "couldn't marshal the Dgraph schema")
+	}
+	return schemaVersion(string(b)), nil
+}
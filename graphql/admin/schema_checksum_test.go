@@ -0,0 +1,76 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package admin
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDgraphSchemaChecksum(t *testing.T) {
+	parse := func(sch string) *dgraphSchema {
+		var res dgraphSchema
+		require.NoError(t, json.Unmarshal([]byte(sch), &res))
+		return &res
+	}
+	checksum := func(sch string) string {
+		sum, err := dgraphSchemaChecksum(parse(sch))
+		require.NoError(t, err)
+		return sum
+	}
+
+	// The same schema, as returned by two alphas in different orders.
+	node1 := checksum(`{
+		"schema": [
+			{"predicate": "name", "type": "string", "index": true,
+				"tokenizer": ["exact", "term"]},
+			{"predicate": "age", "type": "int"}
+		],
+		"types": [
+			{"name": "Person", "fields": [{"name": "name"}, {"name": "age"}]},
+			{"name": "Pet", "fields": [{"name": "name"}]}
+		]
+	}`)
+	node2 := checksum(`{
+		"schema": [
+			{"predicate": "age", "type": "int"},
+			{"predicate": "name", "type": "string", "index": true,
+				"tokenizer": ["term", "exact"]}
+		],
+		"types": [
+			{"name": "Pet", "fields": [{"name": "name"}]},
+			{"name": "Person", "fields": [{"name": "age"}, {"name": "name"}]}
+		]
+	}`)
+	require.Equal(t, node1, node2)
+
+	// The schema of the second alpha is updated to index age.
+	updated := checksum(`{
+		"schema": [
+			{"predicate": "age", "type": "int", "index": true, "tokenizer": ["int"]},
+			{"predicate": "name", "type": "string", "index": true,
+				"tokenizer": ["term", "exact"]}
+		],
+		"types": [
+			{"name": "Pet", "fields": [{"name": "name"}]},
+			{"name": "Person", "fields": [{"name": "age"}, {"name": "name"}]}
+		]
+	}`)
+	require.NotEqual(t, node1, updated)
+}
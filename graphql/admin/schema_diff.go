@@ -0,0 +1,161 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package admin
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/dgraph-io/dgraph/graphql/schema"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	dschema "github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/types"
+	"github.com/dgraph-io/dgraph/x"
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+)
+
+// schemaDiff is the difference between two Dgraph schemas, by predicate and type name.
+type schemaDiff struct {
+	AddedPredicates    []string
+	RemovedPredicates  []string
+	ModifiedPredicates []string
+	AddedTypes         []string
+	RemovedTypes       []string
+	ModifiedTypes      []string
+
+	// incompatible describes the changes that aren't backward compatible, as they orphan data
+	// or change how it's interpreted.
+	incompatible []string
+}
+
+// diffDgraphSchemas returns the difference between the Dgraph schemas oldSchema and newSchema.
+func diffDgraphSchemas(oldSchema, newSchema string) (*schemaDiff, error) {
+	oldParsed, err := dschema.Parse(oldSchema)
+	if err != nil {
+		return nil, errors.Wrapf(err, "couldn't parse the previous Dgraph schema")
+	}
+	newParsed, err := dschema.Parse(newSchema)
+	if err != nil {
+		return nil, errors.Wrapf(err, "couldn't parse the new Dgraph schema")
+	}
+
+	oldPreds := make(map[string]proto.Message)
+	for _, pred := range oldParsed.Preds {
+		oldPreds[pred.Predicate] = pred
+	}
+	newPreds := make(map[string]proto.Message)
+	for _, pred := range newParsed.Preds {
+		newPreds[pred.Predicate] = pred
+	}
+	oldTypes := make(map[string]proto.Message)
+	for _, typ := range oldParsed.Types {
+		oldTypes[typ.TypeName] = typ
+	}
+	newTypes := make(map[string]proto.Message)
+	for _, typ := range newParsed.Types {
+		newTypes[typ.TypeName] = typ
+	}
+
+	diff := &schemaDiff{}
+	diff.AddedPredicates, diff.RemovedPredicates, diff.ModifiedPredicates =
+		diffByName(oldPreds, newPreds)
+	diff.AddedTypes, diff.RemovedTypes, diff.ModifiedTypes = diffByName(oldTypes, newTypes)
+
+	for _, pred := range diff.RemovedPredicates {
+		diff.incompatible = append(diff.incompatible,
+			fmt.Sprintf("predicate %s is removed", pred))
+	}
+	for _, typ := range diff.RemovedTypes {
+		diff.incompatible = append(diff.incompatible, fmt.Sprintf("type %s is removed", typ))
+	}
+	for _, name := range diff.ModifiedPredicates {
+		oldPred := oldPreds[name].(*pb.SchemaUpdate)
+		newPred := newPreds[name].(*pb.SchemaUpdate)
+		if oldPred.ValueType != newPred.ValueType {
+			diff.incompatible = append(diff.incompatible, fmt.Sprintf(
+				"predicate %s changes type from %s to %s", name,
+				types.TypeID(oldPred.ValueType).Name(), types.TypeID(newPred.ValueType).Name()))
+		}
+		if oldPred.List && !newPred.List {
+			diff.incompatible = append(diff.incompatible,
+				fmt.Sprintf("predicate %s changes from a list to a single value", name))
+		}
+	}
+	return diff, nil
+}
+
+// diffByName returns the sorted names that are only in newDefs, only in oldDefs, and in both
+// but with different definitions.
+func diffByName(oldDefs, newDefs map[string]proto.Message) (added, removed, modified []string) {
+	added, removed, modified = []string{}, []string{}, []string{}
+	for name, def := range newDefs {
+		oldDef, ok := oldDefs[name]
+		switch {
+		case !ok:
+			added = append(added, name)
+		case !proto.Equal(oldDef, def):
+			modified = append(modified, name)
+		}
+	}
+	for name := range oldDefs {
+		if _, ok := newDefs[name]; !ok {
+			removed = append(removed, name)
+		}
+	}
+	sort.Strings(added)
+	sort.Strings(removed)
+	sort.Strings(modified)
+	return added, removed, modified
+}
+
+// toJSON writes the fields of the diff selected by field as a JSON object.
+func (diff *schemaDiff) toJSON(field schema.Field) []byte {
+	var buf bytes.Buffer
+	x.Check2(buf.WriteString("{"))
+	for i, sel := range field.SelectionSet() {
+		var names []string
+		switch sel.Name() {
+		case "addedPredicates":
+			names = diff.AddedPredicates
+		case "removedPredicates":
+			names = diff.RemovedPredicates
+		case "modifiedPredicates":
+			names = diff.ModifiedPredicates
+		case "addedTypes":
+			names = diff.AddedTypes
+		case "removedTypes":
+			names = diff.RemovedTypes
+		case "modifiedTypes":
+			names = diff.ModifiedTypes
+		}
+		val, err := json.Marshal(names)
+		x.Check2(val, err)
+
+		if i != 0 {
+			x.Check2(buf.WriteString(","))
+		}
+		x.Check2(buf.WriteString(`"`))
+		x.Check2(buf.WriteString(sel.ResponseName()))
+		x.Check2(buf.WriteString(`":`))
+		x.Check2(buf.Write(val))
+	}
+	x.Check2(buf.WriteString("}"))
+	return buf.Bytes()
+}
@@ -0,0 +1,72 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package admin
+
+import (
+	"testing"
+
+	"github.com/dgraph-io/dgraph/graphql/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func dgraphSchemaOf(t *testing.T, gqlSchema string) string {
+	handler, err := schema.NewHandler(gqlSchema)
+	require.NoError(t, err)
+	return handler.DGSchema()
+}
+
+func TestDiffDgraphSchemas(t *testing.T) {
+	first := dgraphSchemaOf(t, `
+	type A {
+		b: String
+	}`)
+	updated := dgraphSchemaOf(t, `
+	type A {
+		b: String
+		c: Int
+	}`)
+
+	diff, err := diffDgraphSchemas(first, updated)
+	require.NoError(t, err)
+	require.Equal(t, &schemaDiff{
+		AddedPredicates:    []string{"A.c"},
+		RemovedPredicates:  []string{},
+		ModifiedPredicates: []string{},
+		AddedTypes:         []string{},
+		RemovedTypes:       []string{},
+		ModifiedTypes:      []string{"A"},
+	}, diff)
+
+	diff, err = diffDgraphSchemas(updated, first)
+	require.NoError(t, err)
+	require.Equal(t, []string{"A.c"}, diff.RemovedPredicates)
+	require.Equal(t, []string{"predicate A.c is removed"}, diff.incompatible)
+
+	diff, err = diffDgraphSchemas("", first)
+	require.NoError(t, err)
+	require.Equal(t, []string{"A.b"}, diff.AddedPredicates)
+	require.Equal(t, []string{"A"}, diff.AddedTypes)
+	require.Empty(t, diff.incompatible)
+
+	diff, err = diffDgraphSchemas(first, dgraphSchemaOf(t, `
+	type A {
+		b: Int
+	}`))
+	require.NoError(t, err)
+	require.Equal(t, []string{"predicate A.b changes type from string to int"},
+		diff.incompatible)
+}
@@ -0,0 +1,99 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package admin
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/dgraph-io/dgraph/graphql/schema"
+	"github.com/dgraph-io/dgraph/worker"
+	"github.com/pkg/errors"
+)
+
+const (
+	// maxSchemaSize is the largest GraphQL schema updateGQLSchemaFromURL downloads.
+	maxSchemaSize = 10 << 20
+	fetchTimeout  = 30 * time.Second
+)
+
+// schemaFromURL reads the GraphQL schema of the updateGQLSchemaFromURL mutation m from the URL
+// it's given.
+func schemaFromURL(m schema.Mutation) (*updateGQLSchemaInput, error) {
+	rawURL, _ := m.ArgValue("url").(string)
+	sch, err := fetchSchema(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	return &updateGQLSchemaInput{Set: gqlSchema{Schema: sch}}, nil
+}
+
+// fetchSchema downloads the GraphQL schema at rawURL. To keep the admin API from being used to
+// reach arbitrary hosts, the URL, and any URL it redirects to, must be on one of the hosts in
+// worker.Config.GraphqlSchemaURLHosts.
+func fetchSchema(rawURL string) (string, error) {
+	if err := checkSchemaURL(rawURL); err != nil {
+		return "", err
+	}
+
+	client := &http.Client{
+		Timeout: fetchTimeout,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return checkSchemaURL(req.URL.String())
+		},
+	}
+	resp, err := client.Get(rawURL)
+	if err != nil {
+		return "", errors.Wrapf(err, "couldn't fetch the schema from %s", rawURL)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", errors.Errorf("couldn't fetch the schema from %s: got status %s", rawURL,
+			resp.Status)
+	}
+	b, err := ioutil.ReadAll(&io.LimitedReader{R: resp.Body, N: maxSchemaSize + 1})
+	if err != nil {
+		return "", errors.Wrapf(err, "couldn't read the schema from %s", rawURL)
+	}
+	if len(b) > maxSchemaSize {
+		return "", errors.Errorf("the schema at %s is larger than %d bytes", rawURL,
+			maxSchemaSize)
+	}
+	return string(b), nil
+}
+
+func checkSchemaURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return errors.Wrapf(err, "invalid schema URL %s", rawURL)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return errors.Errorf("schemas can only be fetched over http or https, not from %s",
+			rawURL)
+	}
+	for _, host := range worker.Config.GraphqlSchemaURLHosts {
+		if strings.EqualFold(u.Hostname(), strings.TrimSpace(host)) {
+			return nil
+		}
+	}
+	return errors.Errorf("host %s isn't allowed by --graphql_schema_url_hosts", u.Hostname())
+}
@@ -0,0 +1,74 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package admin
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/dgraph-io/dgraph/graphql/schema"
+	"github.com/dgraph-io/dgraph/worker"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFetchSchema(t *testing.T) {
+	const types = `
+	type A {
+		b: String
+		c: Int
+		d: Float
+	}`
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/redirect" {
+			http.Redirect(w, r, "http://example.com/schema.graphql", http.StatusFound)
+			return
+		}
+		fmt.Fprint(w, types)
+	}))
+	defer srv.Close()
+	srvURL, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+
+	oldConfig := worker.Config
+	defer func() {
+		worker.Config = oldConfig
+	}()
+
+	worker.Config.GraphqlSchemaURLHosts = nil
+	_, err = fetchSchema(srv.URL + "/schema.graphql")
+	require.Error(t, err, "no host is allowed by default")
+
+	worker.Config.GraphqlSchemaURLHosts = []string{srvURL.Hostname()}
+	sch, err := fetchSchema(srv.URL + "/schema.graphql")
+	require.NoError(t, err)
+	require.Equal(t, types, sch)
+
+	// The schema is applied like one given to updateGQLSchema.
+	handler, err := schema.NewHandler(sch)
+	require.NoError(t, err)
+	require.Equal(t, "type A {\n  A.b\n  A.c\n  A.d\n}\nA.b: string .\nA.c: int .\nA.d: float .\n",
+		handler.DGSchema())
+
+	_, err = fetchSchema(srv.URL + "/redirect")
+	require.Error(t, err, "redirects to hosts that aren't allowed should fail")
+	_, err = fetchSchema(strings.Replace(srv.URL, "http", "ftp", 1))
+	require.Error(t, err)
+}
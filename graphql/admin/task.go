@@ -0,0 +1,178 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/dgraph-io/dgraph/gql"
+	"github.com/dgraph-io/dgraph/graphql/schema"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+)
+
+type taskStatus string
+
+const (
+	taskQueued  taskStatus = "Queued"
+	taskRunning taskStatus = "Running"
+	taskDone    taskStatus = "Done"
+	taskFailed  taskStatus = "Failed"
+
+	// taskTTL is how long a task can still be looked up after it's finished.
+	taskTTL = 24 * time.Hour
+)
+
+// taskInfo is the state of a long-running operation started through the admin API, like an
+// export.
+type taskInfo struct {
+	ID     string     `json:"id"`
+	Kind   string     `json:"kind"`
+	Status taskStatus `json:"status"`
+	// Progress is the percentage of the task that's done.
+	Progress float64 `json:"progress"`
+	Error    string  `json:"error,omitempty"`
+}
+
+// taskFunc is the function run by a task. It can report its progress as a percentage with
+// setProgress.
+type taskFunc func(setProgress func(percent float64)) error
+
+// taskRegistry keeps track of the tasks started through the admin API of this alpha. It's kept in
+// memory, so a task can only be looked up on the alpha that started it, until it restarts.
+// Tasks of the same kind are run one at a time, in the order they were started. Finished tasks
+// are forgotten once they've been finished for longer than ttl.
+type taskRegistry struct {
+	sync.Mutex
+	lastId uint64
+	ttl    time.Duration
+	tasks  map[string]*taskInfo
+	// finished has the time each of the finished tasks finished at.
+	finished map[string]time.Time
+	// lastOfKind has, for each kind of task, a channel that's closed when the last task of that
+	// kind that was started has finished.
+	lastOfKind map[string]chan struct{}
+}
+
+var tasks = newTaskRegistry()
+
+func newTaskRegistry() *taskRegistry {
+	return &taskRegistry{
+		ttl:        taskTTL,
+		tasks:      make(map[string]*taskInfo),
+		finished:   make(map[string]time.Time),
+		lastOfKind: make(map[string]chan struct{}),
+	}
+}
+
+// run queues f to run in the background as a task of the given kind, and returns the id of the
+// task.
+func (r *taskRegistry) run(kind string, f taskFunc) string {
+	r.Lock()
+	r.evict()
+	r.lastId++
+	task := &taskInfo{
+		ID:     fmt.Sprintf("%s-%d", kind, r.lastId),
+		Kind:   kind,
+		Status: taskQueued,
+	}
+	r.tasks[task.ID] = task
+	// Each task waits for the one of the same kind that was started before it.
+	previous := r.lastOfKind[kind]
+	finished := make(chan struct{})
+	r.lastOfKind[kind] = finished
+	r.Unlock()
+
+	go func() {
+		if previous != nil {
+			<-previous
+		}
+		defer close(finished)
+
+		r.update(task, func() { task.Status = taskRunning })
+		err := f(func(percent float64) {
+			r.update(task, func() { task.Progress = percent })
+		})
+		if err != nil {
+			glog.Errorf("Task %s failed: %v", task.ID, err)
+			r.update(task, func() {
+				task.Status, task.Error = taskFailed, err.Error()
+				r.finished[task.ID] = time.Now()
+			})
+			return
+		}
+		glog.Infof("Task %s is done", task.ID)
+		r.update(task, func() {
+			task.Status, task.Progress = taskDone, 100
+			r.finished[task.ID] = time.Now()
+		})
+	}()
+	return task.ID
+}
+
+// update runs f, which changes task, while holding the lock of the registry.
+func (r *taskRegistry) update(task *taskInfo, f func()) {
+	r.Lock()
+	defer r.Unlock()
+	f()
+}
+
+// get returns a copy of the task with the given id, if there is one.
+func (r *taskRegistry) get(id string) (taskInfo, bool) {
+	r.Lock()
+	defer r.Unlock()
+	r.evict()
+	task, ok := r.tasks[id]
+	if !ok {
+		return taskInfo{}, false
+	}
+	return *task, true
+}
+
+// evict removes the tasks that have been finished for longer than the ttl of the registry. It must
+// be called while holding the lock of the registry.
+func (r *taskRegistry) evict() {
+	for id, finishedAt := range r.finished {
+		if time.Since(finishedAt) > r.ttl {
+			delete(r.tasks, id)
+			delete(r.finished, id)
+		}
+	}
+}
+
+type taskResolver struct {
+	id string
+}
+
+func (tr *taskResolver) Rewrite(q schema.Query) (*gql.GraphQuery, error) {
+	tr.id, _ = q.ArgValue("id").(string)
+	return nil, nil
+}
+
+func (tr *taskResolver) Query(ctx context.Context, query *gql.GraphQuery) ([]byte, error) {
+	task, ok := tasks.get(tr.id)
+	if !ok {
+		return []byte(`{"task": null}`), nil
+	}
+
+	b, err := json.Marshal(map[string]interface{}{"task": task})
+	return b, errors.Wrapf(err, "couldn't marshal task %s", tr.id)
+}
@@ -0,0 +1,90 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package admin
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func waitForTask(t *testing.T, r *taskRegistry, id string, status taskStatus) taskInfo {
+	for i := 0; i < 100; i++ {
+		task, ok := r.get(id)
+		require.True(t, ok)
+		if task.Status == status {
+			return task
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("task %s didn't get to status %s", id, status)
+	return taskInfo{}
+}
+
+func TestTaskRegistry(t *testing.T) {
+	r := newTaskRegistry()
+
+	proceed := make(chan struct{})
+	first := r.run("export", func(setProgress func(float64)) error {
+		setProgress(50)
+		<-proceed
+		return nil
+	})
+	second := r.run("export", func(setProgress func(float64)) error {
+		return errors.New("disk full")
+	})
+
+	task := waitForTask(t, r, first, taskRunning)
+	require.Equal(t, taskInfo{ID: first, Kind: "export", Status: taskRunning, Progress: 50}, task)
+	task, _ = r.get(second)
+	require.Equal(t, taskQueued, task.Status, "tasks of the same kind should run one at a time")
+
+	close(proceed)
+	task = waitForTask(t, r, first, taskDone)
+	require.Equal(t, taskInfo{ID: first, Kind: "export", Status: taskDone, Progress: 100}, task)
+	task = waitForTask(t, r, second, taskFailed)
+	require.Equal(t, "disk full", task.Error)
+
+	_, ok := r.get("export-0")
+	require.False(t, ok)
+}
+
+func TestTaskRegistryEvictsFinishedTasks(t *testing.T) {
+	r := newTaskRegistry()
+	r.ttl = 50 * time.Millisecond
+
+	proceed := make(chan struct{})
+	done := r.run("export", func(setProgress func(float64)) error {
+		return nil
+	})
+	running := r.run("backup", func(setProgress func(float64)) error {
+		<-proceed
+		return nil
+	})
+	defer close(proceed)
+
+	waitForTask(t, r, done, taskDone)
+	waitForTask(t, r, running, taskRunning)
+	time.Sleep(2 * r.ttl)
+
+	_, ok := r.get(done)
+	require.False(t, ok, "finished tasks should be evicted after the ttl")
+	_, ok = r.get(running)
+	require.True(t, ok, "running tasks shouldn't be evicted")
+}
@@ -0,0 +1,639 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	dgoapi "github.com/dgraph-io/dgo/v2/protos/api"
+	"github.com/dgraph-io/dgraph/edgraph"
+	"github.com/dgraph-io/dgraph/gql"
+	"github.com/dgraph-io/dgraph/graphql/resolve"
+	"github.com/dgraph-io/dgraph/graphql/schema"
+	"github.com/dgraph-io/dgraph/x"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+)
+
+const (
+	// minPasswordLength is the length that passwords are required to have by types.Encrypt.
+	minPasswordLength = 6
+
+	// userQueryVar is the variable the upsert queries below assign the matched user to.
+	userQueryVar = "x"
+
+	// groupMembersVar is the variable queryUser assigns the members of the group it's limited
+	// to.
+	groupMembersVar = "groupMembers"
+)
+
+// userGroupRewriter rewrites queryUser in the same way as the standard query rewriter, but if
+// the group argument is set, it also limits the users to the members of that group.
+type userGroupRewriter struct{}
+
+func (ur *userGroupRewriter) Rewrite(q schema.Query) (*gql.GraphQuery, error) {
+	dgQuery, err := resolve.NewQueryRewriter().Rewrite(q)
+	if err != nil {
+		return nil, err
+	}
+
+	group, ok := q.ArgValue("group").(string)
+	if !ok {
+		return dgQuery, nil
+	}
+
+	membersFilter := &gql.FilterTree{
+		Func: &gql.Function{
+			Name: "uid",
+			Args: []gql.Arg{{Value: groupMembersVar}},
+		},
+	}
+	if dgQuery.Filter == nil {
+		dgQuery.Filter = membersFilter
+	} else {
+		dgQuery.Filter = &gql.FilterTree{
+			Op:    "and",
+			Child: []*gql.FilterTree{dgQuery.Filter, membersFilter},
+		}
+	}
+
+	return &gql.GraphQuery{
+		Children: []*gql.GraphQuery{
+			{
+				Attr: "var",
+				Func: &gql.Function{
+					Name: "eq",
+					Args: []gql.Arg{{Value: "dgraph.xid"}, {Value: fmt.Sprintf("%q", group)}},
+				},
+				Filter: &gql.FilterTree{
+					Func: &gql.Function{
+						Name: "type",
+						Args: []gql.Arg{{Value: "Group"}},
+					},
+				},
+				Children: []*gql.GraphQuery{{Var: groupMembersVar, Attr: "~dgraph.user.group"}},
+			},
+			dgQuery,
+		},
+	}, nil
+}
+
+// removeUserGroupsRewriter rewrites removeUserFromAllGroups into an upsert that deletes
+// every dgraph.user.group edge of the named user.
+type removeUserGroupsRewriter struct{}
+
+func (rr *removeUserGroupsRewriter) Rewrite(
+	m schema.Mutation) (*gql.GraphQuery, []*dgoapi.Mutation, error) {
+	glog.Info("Got removeUserFromAllGroups request through GraphQL admin API")
+
+	name, _ := m.ArgValue("name").(string)
+	upsertQuery := userUpsertQuery(m, name)
+
+	// A null value in a delete mutation removes all the values of that predicate.
+	deletes, err := json.Marshal(map[string]interface{}{
+		"uid":               fmt.Sprintf("uid(%s)", userQueryVar),
+		"dgraph.user.group": nil,
+	})
+	if err != nil {
+		return nil, nil, schema.GQLWrapf(err, "couldn't rewrite mutation %s", m.Name())
+	}
+
+	return upsertQuery, []*dgoapi.Mutation{{DeleteJson: deletes}}, nil
+}
+
+func (rr *removeUserGroupsRewriter) FromMutationResult(
+	mutation schema.Mutation,
+	assigned map[string]string,
+	result map[string]interface{}) (*gql.GraphQuery, error) {
+
+	// The upsert query is named after the mutation, exactly as it would be for updateUser, so
+	// the update rewriter can build the query that returns the mutated user.
+	return resolve.NewUpdateRewriter().FromMutationResult(mutation, assigned, result)
+}
+
+// setUserEnabledRewriter rewrites setUserEnabled into an upsert that sets the
+// dgraph.user.disabled flag of the named user.
+type setUserEnabledRewriter struct{}
+
+func (sr *setUserEnabledRewriter) Rewrite(
+	m schema.Mutation) (*gql.GraphQuery, []*dgoapi.Mutation, error) {
+	glog.Info("Got setUserEnabled request through GraphQL admin API")
+
+	name, _ := m.ArgValue("name").(string)
+	enabled, _ := m.ArgValue("enabled").(bool)
+	if name == x.GrootId && !enabled {
+		return nil, nil, errors.Errorf("the %s user can't be disabled", x.GrootId)
+	}
+
+	sets, err := json.Marshal(map[string]interface{}{
+		"uid":                  fmt.Sprintf("uid(%s)", userQueryVar),
+		"dgraph.user.disabled": !enabled,
+	})
+	if err != nil {
+		return nil, nil, schema.GQLWrapf(err, "couldn't rewrite mutation %s", m.Name())
+	}
+
+	return userUpsertQuery(m, name), []*dgoapi.Mutation{{SetJson: sets}}, nil
+}
+
+func (sr *setUserEnabledRewriter) FromMutationResult(
+	mutation schema.Mutation,
+	assigned map[string]string,
+	result map[string]interface{}) (*gql.GraphQuery, error) {
+
+	return resolve.NewUpdateRewriter().FromMutationResult(mutation, assigned, result)
+}
+
+// updatePasswordRewriter rewrites updateUserPassword into an upsert that sets the password of the
+// named user. The new password has to be given twice, so that a typo can't lock the user, which
+// may be groot, out.
+type updatePasswordRewriter struct{}
+
+func (ur *updatePasswordRewriter) Rewrite(
+	m schema.Mutation) (*gql.GraphQuery, []*dgoapi.Mutation, error) {
+	glog.Info("Got updateUserPassword request through GraphQL admin API")
+
+	name, _ := m.ArgValue("name").(string)
+	password, _ := m.ArgValue("password").(string)
+	confirm, _ := m.ArgValue("confirmPassword").(string)
+	if password != confirm {
+		return nil, nil, errors.Errorf("password and confirmPassword don't match")
+	}
+	if len(password) < minPasswordLength {
+		return nil, nil, errors.Errorf("the password must have at least %d characters",
+			minPasswordLength)
+	}
+
+	sets, err := json.Marshal(map[string]interface{}{
+		"uid":             fmt.Sprintf("uid(%s)", userQueryVar),
+		"dgraph.password": password,
+	})
+	if err != nil {
+		return nil, nil, schema.GQLWrapf(err, "couldn't rewrite mutation %s", m.Name())
+	}
+
+	return userUpsertQuery(m, name), []*dgoapi.Mutation{{SetJson: sets}}, nil
+}
+
+func (ur *updatePasswordRewriter) FromMutationResult(
+	mutation schema.Mutation,
+	assigned map[string]string,
+	result map[string]interface{}) (*gql.GraphQuery, error) {
+
+	return resolve.NewUpdateRewriter().FromMutationResult(mutation, assigned, result)
+}
+
+// userGroupUids returns the uids of the groups of the users with the given names, mapped by user
+// name. Users that don't exist are not in the result.
+func userGroupUids(ctx context.Context, names ...string) (map[string][]string, error) {
+	namesJSON, err := json.Marshal(names)
+	if err != nil {
+		return nil, err
+	}
+	query := &gql.GraphQuery{
+		Attr: "users",
+		Func: &gql.Function{
+			Name: "eq",
+			Args: []gql.Arg{{Value: "dgraph.xid"}, {Value: string(namesJSON)}},
+		},
+		Filter: &gql.FilterTree{
+			Func: &gql.Function{
+				Name: "type",
+				Args: []gql.Arg{{Value: "User"}},
+			},
+		},
+		Children: []*gql.GraphQuery{
+			{Attr: "dgraph.xid"},
+			{
+				Attr:     "dgraph.user.group",
+				Children: []*gql.GraphQuery{{Attr: "uid"}},
+			},
+		},
+	}
+
+	resp, err := resolve.AdminQueryExecutor().Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var res struct {
+		Users []struct {
+			Name   string `json:"dgraph.xid"`
+			Groups []struct {
+				Uid string `json:"uid"`
+			} `json:"dgraph.user.group"`
+		} `json:"users"`
+	}
+	if err := json.Unmarshal(resp, &res); err != nil {
+		return nil, errors.Wrapf(err, "couldn't unmarshal users")
+	}
+
+	groups := make(map[string][]string)
+	for _, user := range res.Users {
+		uids := make([]string, 0, len(user.Groups))
+		for _, group := range user.Groups {
+			uids = append(uids, group.Uid)
+		}
+		groups[user.Name] = uids
+	}
+	return groups, nil
+}
+
+// copyUserGroups resolves the copyUserGroups mutation, which adds the user to to every group
+// the user from is a member of. If replace is set, to is also removed from the groups from isn't
+// a member of. The groups of the members of guardians and readonly-guardians can't be copied, so
+// that membership of those groups can't be granted this way, and replace can't remove the last
+// member of guardians from it.
+func copyUserGroups(ctx context.Context, m schema.Mutation) (*resolve.Resolved, bool) {
+	from, _ := m.ArgValue("from").(string)
+	to, _ := m.ArgValue("to").(string)
+	replace, _ := m.ArgValue("replace").(bool)
+	if replace {
+		guardiansMu.Lock()
+		defer guardiansMu.Unlock()
+	}
+	var err error
+	if from, err = edgraph.ResolveUserId(ctx, from); err != nil {
+		return failedMutation(m, err)
+	}
+	if to, err = edgraph.ResolveUserId(ctx, to); err != nil {
+		return failedMutation(m, err)
+	}
+
+	if from == to {
+		return failedMutation(m, errors.Errorf("can't copy the groups of %s to itself", from))
+	}
+	for _, group := range []string{x.GuardiansId, x.ReadonlyGuardiansId} {
+		members, err := groupMemberNames(ctx, group, false)
+		if err != nil {
+			return failedMutation(m, err)
+		}
+		for _, member := range members {
+			if member == from {
+				return failedMutation(m, errors.Errorf("the groups of %s can't be copied "+
+					"because it is a member of the %s group", from, group))
+			}
+		}
+	}
+
+	groups, err := userGroupUids(ctx, from, to)
+	if err != nil {
+		return failedMutation(m, err)
+	}
+	sourceGroups, ok := groups[from]
+	if !ok {
+		return failedMutation(m, errors.Errorf("user %s doesn't exist", from))
+	}
+	targetGroups, ok := groups[to]
+	if !ok {
+		return failedMutation(m, errors.Errorf("user %s doesn't exist", to))
+	}
+
+	copied := make(map[string]bool)
+	var set, del []interface{}
+	for _, uid := range sourceGroups {
+		copied[uid] = true
+		set = append(set, map[string]interface{}{"uid": uid})
+	}
+	if replace {
+		// The source isn't a guardian, so replacing the groups of a guardian removes it from
+		// guardians.
+		if resolved := lastGuardianError(ctx, m, []string{to}); resolved != nil {
+			return resolved, false
+		}
+		for _, uid := range targetGroups {
+			if !copied[uid] {
+				del = append(del, map[string]interface{}{"uid": uid})
+			}
+		}
+	}
+
+	target := fmt.Sprintf("uid(%s)", userQueryVar)
+	mutation := &dgoapi.Mutation{}
+	if len(set) > 0 {
+		if mutation.SetJson, err = json.Marshal(map[string]interface{}{
+			"uid":               target,
+			"dgraph.user.group": set,
+		}); err != nil {
+			return failedMutation(m, err)
+		}
+	}
+	if len(del) > 0 {
+		if mutation.DeleteJson, err = json.Marshal(map[string]interface{}{
+			"uid":               target,
+			"dgraph.user.group": del,
+		}); err != nil {
+			return failedMutation(m, err)
+		}
+	}
+	var mutations []*dgoapi.Mutation
+	if len(set) > 0 || len(del) > 0 {
+		mutations = append(mutations, mutation)
+	}
+
+	return resolve.NewMutationResolver(
+		&precomputedRewriter{query: userUpsertQuery(m, to), mutations: mutations},
+		resolve.DgraphAsQueryExecutor(),
+		resolve.DgraphAsMutationExecutor(),
+		resolve.StdMutationCompletion(m.Name())).Resolve(ctx, m)
+}
+
+// userUpsertQuery builds an upsert query that finds the user with the given name, assigns it
+// to userQueryVar and returns its uid in a block named after the mutation.
+func userUpsertQuery(m schema.Mutation, name string) *gql.GraphQuery {
+	return &gql.GraphQuery{
+		Children: []*gql.GraphQuery{{
+			Var:  userQueryVar,
+			Attr: m.ResponseName(),
+			Func: &gql.Function{
+				Name: "eq",
+				Args: []gql.Arg{{Value: "dgraph.xid"}, {Value: fmt.Sprintf("%q", name)}},
+			},
+			Filter: &gql.FilterTree{
+				Func: &gql.Function{
+					Name: "type",
+					Args: []gql.Arg{{Value: "User"}},
+				},
+			},
+			Children: []*gql.GraphQuery{{Attr: "uid"}},
+		}},
+	}
+}
+
+// normalizeNewUserNames wraps the addUser resolver mr so that the names of the new users are
+// normalized before they are added. This makes case variants of an existing user name collide
+// with it when user names are case-insensitive.
+func normalizeNewUserNames(mr resolve.MutationResolver) resolve.MutationResolver {
+	return resolve.MutationResolverFunc(
+		func(ctx context.Context, m schema.Mutation) (*resolve.Resolved, bool) {
+			inputs, _ := m.ArgValue(schema.InputArgName).([]interface{})
+			for _, input := range inputs {
+				user, ok := input.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				if name, ok := user["name"].(string); ok {
+					user["name"] = edgraph.NormalizeUserId(name)
+				}
+			}
+			m.SetArgTo(schema.InputArgName, inputs)
+			return mr.Resolve(ctx, m)
+		})
+}
+
+// resolveUserNames wraps the mutation resolver mr so that the user names in the given arguments
+// are replaced by the ids of the users they refer to, as given by edgraph.ResolveUserId, before
+// mr resolves the mutation.
+func resolveUserNames(mr resolve.MutationResolver, args ...string) resolve.MutationResolver {
+	return resolve.MutationResolverFunc(
+		func(ctx context.Context, m schema.Mutation) (*resolve.Resolved, bool) {
+			for _, arg := range args {
+				name, ok := m.ArgValue(arg).(string)
+				if !ok {
+					continue
+				}
+				userId, err := edgraph.ResolveUserId(ctx, name)
+				if err != nil {
+					return failedMutation(m, err)
+				}
+				m.SetArgTo(arg, userId)
+			}
+			return mr.Resolve(ctx, m)
+		})
+}
+
+// resolveUserNameQuery wraps the query resolver qr like resolveUserNames wraps mutation
+// resolvers.
+func resolveUserNameQuery(qr resolve.QueryResolver, args ...string) resolve.QueryResolver {
+	return resolve.QueryResolverFunc(
+		func(ctx context.Context, q schema.Query) *resolve.Resolved {
+			for _, arg := range args {
+				name, ok := q.ArgValue(arg).(string)
+				if !ok {
+					continue
+				}
+				userId, err := edgraph.ResolveUserId(ctx, name)
+				if err != nil {
+					return &resolve.Resolved{
+						Err: schema.GQLWrapLocationf(err, q.Location(), "%s failed", q.Name()),
+					}
+				}
+				q.SetArgTo(arg, userId)
+			}
+			return qr.Resolve(ctx, q)
+		})
+}
+
+// guardiansMu is held while a mutation that can remove or disable members of the guardians group
+// checks that it leaves some and runs, so that two such mutations can't each see the other's
+// users remain, and together remove them all. It only orders the mutations sent to this Alpha.
+var guardiansMu sync.Mutex
+
+// protectGuardians wraps the updateUser, deleteUser, removeUserFromAllGroups or setUserEnabled
+// resolver mr so that it refuses to remove or disable the last enabled member of the guardians
+// group, which would leave no one able to administer the ACL data, and to delete the groot user.
+// The users that updateUser and deleteUser apply to are found by Dgraph, with the mutation's own
+// filter.
+func protectGuardians(mr resolve.MutationResolver) resolve.MutationResolver {
+	return resolve.MutationResolverFunc(
+		func(ctx context.Context, m schema.Mutation) (*resolve.Resolved, bool) {
+			switch m.Name() {
+			case "updateUser":
+				input, _ := m.ArgValue(schema.InputArgName).(map[string]interface{})
+				remove, _ := input["remove"].(map[string]interface{})
+				groups, _ := remove["groups"].([]interface{})
+				removesGuardians := false
+				for _, group := range groups {
+					ref, _ := group.(map[string]interface{})
+					if name, _ := ref["name"].(string); name == x.GuardiansId {
+						removesGuardians = true
+					}
+				}
+				if !removesGuardians {
+					return mr.Resolve(ctx, m)
+				}
+			case "setUserEnabled":
+				if enabled, _ := m.ArgValue("enabled").(bool); enabled {
+					return mr.Resolve(ctx, m)
+				}
+			}
+
+			guardiansMu.Lock()
+			defer guardiansMu.Unlock()
+
+			var users []string
+			switch m.Name() {
+			case "updateUser", "deleteUser":
+				var err error
+				if users, err = mutatedNames(ctx, m); err != nil {
+					return failedMutation(m, err)
+				}
+			case "removeUserFromAllGroups", "setUserEnabled":
+				// Removing a user from every group removes it from guardians too.
+				name, _ := m.ArgValue("name").(string)
+				users = []string{name}
+			}
+			if m.Name() == "deleteUser" {
+				for _, user := range users {
+					if user == x.GrootId {
+						return &resolve.Resolved{Err: x.GqlErrorf("%s failed because the %s "+
+							"user can't be deleted", m.Name(), x.GrootId).
+							WithLocations(m.Location())}, false
+					}
+				}
+			}
+
+			if resolved := lastGuardianError(ctx, m, users); resolved != nil {
+				return resolved, false
+			}
+			return mr.Resolve(ctx, m)
+		})
+}
+
+// lastGuardianError returns the result of m failing if users are the last members of the
+// guardians group, so that removing them from it would leave it empty, or, for setUserEnabled,
+// its last enabled members. It returns nil if other members would remain. It must be called
+// with guardiansMu held.
+func lastGuardianError(ctx context.Context, m schema.Mutation, users []string) *resolve.Resolved {
+	disables := m.Name() == "setUserEnabled"
+	guardians, err := groupMemberNames(ctx, x.GuardiansId, disables)
+	if err != nil {
+		return &resolve.Resolved{
+			Err: schema.GQLWrapLocationf(err, m.Location(), "%s failed", m.Name()),
+		}
+	}
+	changed := make(map[string]bool)
+	for _, user := range users {
+		changed[user] = true
+	}
+	remaining := 0
+	for _, guardian := range guardians {
+		if !changed[guardian] {
+			remaining++
+		}
+	}
+	if len(guardians) == 0 || remaining > 0 {
+		return nil
+	}
+	if disables {
+		return &resolve.Resolved{Err: x.GqlErrorf("%s failed because it would disable the "+
+			"last enabled member of the %s group", m.Name(), x.GuardiansId).
+			WithLocations(m.Location())}
+	}
+	return &resolve.Resolved{Err: x.GqlErrorf("%s failed because it would remove the "+
+		"last member of the %s group", m.Name(), x.GuardiansId).
+		WithLocations(m.Location())}
+}
+
+// mutatedNames returns the names of the users or groups that the update or delete mutation m
+// applies to, as found by Dgraph with the filter of m.
+func mutatedNames(ctx context.Context, m schema.Mutation) ([]string, error) {
+	query := resolve.MutatedNodesQuery(m)
+	query.Children = []*gql.GraphQuery{{Attr: "dgraph.xid"}}
+
+	resp, err := resolve.AdminQueryExecutor().Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var res map[string][]struct {
+		Name string `json:"dgraph.xid"`
+	}
+	if err := json.Unmarshal(resp, &res); err != nil {
+		return nil, errors.Wrapf(err, "couldn't unmarshal the nodes %s applies to", m.Name())
+	}
+
+	var names []string
+	for _, node := range res[m.ResponseName()] {
+		names = append(names, node.Name)
+	}
+	return names, nil
+}
+
+// protectReservedGroups wraps the deleteGroup resolver mr so that it refuses to delete the
+// groups created by Dgraph, which the ACL relies on.
+func protectReservedGroups(mr resolve.MutationResolver) resolve.MutationResolver {
+	return resolve.MutationResolverFunc(
+		func(ctx context.Context, m schema.Mutation) (*resolve.Resolved, bool) {
+			groups, err := mutatedNames(ctx, m)
+			if err != nil {
+				return failedMutation(m, err)
+			}
+			for _, group := range groups {
+				if group == x.GuardiansId || group == x.ReadonlyGuardiansId {
+					return &resolve.Resolved{Err: x.GqlErrorf("%s failed because the %s group "+
+						"can't be deleted", m.Name(), group).WithLocations(m.Location())}, false
+				}
+			}
+			return mr.Resolve(ctx, m)
+		})
+}
+
+// groupMemberNames returns the names of the members of group. If enabledOnly is set, the members
+// that are disabled are left out.
+func groupMemberNames(ctx context.Context, group string, enabledOnly bool) ([]string, error) {
+	query := &gql.GraphQuery{
+		Attr: "group",
+		Func: &gql.Function{
+			Name: "eq",
+			Args: []gql.Arg{{Value: "dgraph.xid"}, {Value: fmt.Sprintf("%q", group)}},
+		},
+		Filter: &gql.FilterTree{
+			Func: &gql.Function{
+				Name: "type",
+				Args: []gql.Arg{{Value: "Group"}},
+			},
+		},
+		Children: []*gql.GraphQuery{{
+			Attr: "~dgraph.user.group",
+			Children: []*gql.GraphQuery{
+				{Attr: "dgraph.xid"},
+				{Attr: "dgraph.user.disabled"},
+			},
+		}},
+	}
+
+	resp, err := resolve.AdminQueryExecutor().Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var res struct {
+		Groups []struct {
+			Users []struct {
+				Name     string `json:"dgraph.xid"`
+				Disabled bool   `json:"dgraph.user.disabled"`
+			} `json:"~dgraph.user.group"`
+		} `json:"group"`
+	}
+	if err := json.Unmarshal(resp, &res); err != nil {
+		return nil, errors.Wrapf(err, "couldn't unmarshal the members of %s", group)
+	}
+
+	var names []string
+	for _, g := range res.Groups {
+		for _, user := range g.Users {
+			if !enabledOnly || !user.Disabled {
+				names = append(names, user.Name)
+			}
+		}
+	}
+	return names, nil
+}
@@ -0,0 +1,194 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package admin
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"github.com/dgraph-io/dgraph/gql"
+	"github.com/dgraph-io/dgraph/graphql/resolve"
+	"github.com/dgraph-io/dgraph/graphql/schema"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+)
+
+// userCursorAlias is the alias the name of every user is also read as by queryUserPage, so that
+// the cursor can be built from the last user of the page whichever fields are selected.
+const userCursorAlias = "userPageCursor"
+
+// encodeUserCursor and decodeUserCursor convert the name of the last user of a page to and from
+// the cursor that's returned by queryUserPage and accepted as its after argument.
+func encodeUserCursor(name string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(name))
+}
+
+func decodeUserCursor(cursor string) (string, error) {
+	name, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return "", errors.Errorf("invalid cursor %q", cursor)
+	}
+	return string(name), nil
+}
+
+// userPageUsers is the users field of a queryUserPage query, presented as a queryUser query
+// with the filter and group of queryUserPage, so that it's rewritten in the same way.
+type userPageUsers struct {
+	schema.Field
+	page schema.Query
+}
+
+func (uu *userPageUsers) ArgValue(name string) interface{} {
+	switch name {
+	case "filter", "group":
+		return uu.page.ArgValue(name)
+	}
+	// The order and pagination are set by userPageResolver.
+	return nil
+}
+
+func (uu *userPageUsers) QueryType() schema.QueryType {
+	return schema.FilterQuery
+}
+
+func (uu *userPageUsers) Rename(newName string) {}
+
+// userPageResolver resolves queryUserPage, which returns the users that match a queryUser filter
+// a page at a time. The users are ordered by name, and the cursor returned with a page is the
+// name of its last user, so that the next page starts after it even if users are added or
+// deleted in between.
+type userPageResolver struct {
+	query schema.Query
+	users schema.Field
+	first int64
+}
+
+func (ur *userPageResolver) Rewrite(q schema.Query) (*gql.GraphQuery, error) {
+	glog.Info("Got queryUserPage request through GraphQL admin API")
+
+	ur.query = q
+	for _, f := range q.SelectionSet() {
+		if f.Name() == "users" {
+			ur.users = f
+			break
+		}
+	}
+
+	ur.first = -1
+	if first := q.ArgValue("first"); first != nil {
+		n, err := ruleNumber(first)
+		if err != nil || n < 1 {
+			return nil, errors.Errorf("first must be a positive integer")
+		}
+		ur.first = n
+	}
+	var after string
+	if cursor, ok := q.ArgValue("after").(string); ok {
+		var err error
+		if after, err = decodeUserCursor(cursor); err != nil {
+			return nil, err
+		}
+	}
+
+	if ur.users == nil {
+		return nil, nil
+	}
+	dgQuery, err := (&userGroupRewriter{}).Rewrite(&userPageUsers{Field: ur.users, page: q})
+	if err != nil {
+		return nil, err
+	}
+
+	// userGroupRewriter puts the users block next to a block that finds the group members.
+	usersQuery := dgQuery
+	for _, child := range dgQuery.Children {
+		if child.Attr == ur.users.ResponseName() {
+			usersQuery = child
+		}
+	}
+	usersQuery.Order = []*pb.Order{{Attr: "dgraph.xid"}}
+	if ur.first > 0 {
+		// One more user than the page holds is read, to find out whether there's a next page.
+		usersQuery.Args["first"] = fmt.Sprintf("%d", ur.first+1)
+	}
+	if after != "" {
+		afterFilter := &gql.FilterTree{
+			Func: &gql.Function{
+				Name: "gt",
+				Args: []gql.Arg{{Value: "dgraph.xid"}, {Value: fmt.Sprintf("%q", after)}},
+			},
+		}
+		if usersQuery.Filter == nil {
+			usersQuery.Filter = afterFilter
+		} else {
+			usersQuery.Filter = &gql.FilterTree{
+				Op:    "and",
+				Child: []*gql.FilterTree{usersQuery.Filter, afterFilter},
+			}
+		}
+	}
+	usersQuery.Children = append(usersQuery.Children,
+		&gql.GraphQuery{Attr: "dgraph.xid", Alias: userCursorAlias})
+
+	return dgQuery, nil
+}
+
+func (ur *userPageResolver) Query(ctx context.Context, query *gql.GraphQuery) ([]byte, error) {
+	page := make(map[string]interface{})
+	var cursor interface{}
+
+	if query != nil {
+		resp, err := resolve.DgraphAsQueryExecutor().Query(ctx, query)
+		if err != nil {
+			return nil, err
+		}
+
+		var res map[string][]map[string]json.RawMessage
+		if err := json.Unmarshal(resp, &res); err != nil {
+			return nil, errors.Wrapf(err, "couldn't unmarshal users")
+		}
+		users := res[ur.users.ResponseName()]
+		if ur.first > 0 && int64(len(users)) > ur.first {
+			users = users[:ur.first]
+			var name string
+			if err := json.Unmarshal(users[len(users)-1][userCursorAlias], &name); err != nil {
+				return nil, errors.Wrapf(err, "couldn't unmarshal the name of a user")
+			}
+			cursor = encodeUserCursor(name)
+		}
+		for _, user := range users {
+			delete(user, userCursorAlias)
+		}
+		if users == nil {
+			users = []map[string]json.RawMessage{}
+		}
+		page[ur.users.ResponseName()] = users
+	}
+
+	for _, f := range ur.query.SelectionSet() {
+		if f.Name() == "cursor" {
+			page[f.ResponseName()] = cursor
+		}
+	}
+
+	b, err := json.Marshal(map[string]interface{}{
+		ur.query.ResponseName(): []interface{}{page},
+	})
+	return b, errors.Wrapf(err, "couldn't marshal the page of users")
+}
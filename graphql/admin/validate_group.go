@@ -0,0 +1,164 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/dgraph-io/dgraph/gql"
+	"github.com/dgraph-io/dgraph/graphql/resolve"
+	"github.com/dgraph-io/dgraph/graphql/schema"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+)
+
+// groupMember is a node that has an edge to a group, which is a user unless the user was
+// deleted without its edges.
+type groupMember struct {
+	Uid   string   `json:"uid"`
+	Name  string   `json:"dgraph.xid"`
+	Types []string `json:"dgraph.type"`
+}
+
+type groupWithMembers struct {
+	Name    string        `json:"dgraph.xid"`
+	Rules   []aclRule     `json:"dgraph.acl.rule"`
+	Members []groupMember `json:"~dgraph.user.group"`
+}
+
+type groupValidation struct {
+	Name           string   `json:"name"`
+	Valid          bool     `json:"valid"`
+	DanglingRules  []string `json:"danglingRules"`
+	MissingMembers []string `json:"missingMembers"`
+}
+
+// validateGroupResolver resolves validateGroup, which reports the rules of a group on predicates
+// that aren't in the schema and the members of the group that aren't users anymore. Unlike
+// gcACL, it doesn't change anything.
+type validateGroupResolver struct {
+	name string
+}
+
+func (vr *validateGroupResolver) Rewrite(q schema.Query) (*gql.GraphQuery, error) {
+	glog.Info("Got validateGroup request through GraphQL admin API")
+
+	vr.name, _ = q.ArgValue("name").(string)
+	return &gql.GraphQuery{
+		Attr: "group",
+		Func: &gql.Function{
+			Name: "eq",
+			Args: []gql.Arg{{Value: "dgraph.xid"}, {Value: fmt.Sprintf("%q", vr.name)}},
+		},
+		Filter: &gql.FilterTree{
+			Func: &gql.Function{
+				Name: "type",
+				Args: []gql.Arg{{Value: "Group"}},
+			},
+		},
+		Children: []*gql.GraphQuery{
+			{Attr: "dgraph.xid"},
+			{
+				Attr:     "dgraph.acl.rule",
+				Children: []*gql.GraphQuery{{Attr: "dgraph.rule.predicate"}},
+			},
+			{
+				Attr: "~dgraph.user.group",
+				Children: []*gql.GraphQuery{
+					{Attr: "uid"},
+					{Attr: "dgraph.xid"},
+					{Attr: "dgraph.type"},
+				},
+			},
+		},
+	}, nil
+}
+
+func (vr *validateGroupResolver) Query(
+	ctx context.Context, query *gql.GraphQuery) ([]byte, error) {
+
+	resp, err := resolve.DgraphAsQueryExecutor().Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var res struct {
+		Group []groupWithMembers `json:"group"`
+	}
+	if err := json.Unmarshal(resp, &res); err != nil {
+		return nil, errors.Wrapf(err, "couldn't unmarshal group %s", vr.name)
+	}
+	if len(res.Group) == 0 {
+		return []byte(`{"validateGroup": null}`), nil
+	}
+
+	validation, err := validateGroup(ctx, &res.Group[0])
+	if err != nil {
+		return nil, err
+	}
+	b, err := json.Marshal(map[string]interface{}{"validateGroup": validation})
+	return b, errors.Wrapf(err, "couldn't marshal the validation of group %s", vr.name)
+}
+
+// validateGroup returns the predicates of the rules of group that aren't in the schema, and the
+// uids of the members of group that aren't users.
+func validateGroup(ctx context.Context, group *groupWithMembers) (*groupValidation, error) {
+	validation := &groupValidation{
+		Name:           group.Name,
+		DanglingRules:  []string{},
+		MissingMembers: []string{},
+	}
+
+	var preds []string
+	for _, rule := range group.Rules {
+		preds = append(preds, rule.Predicate)
+	}
+	if len(preds) > 0 {
+		found, err := schemaPredicates(ctx, preds)
+		if err != nil {
+			return nil, err
+		}
+		for _, pred := range preds {
+			if !found[pred] {
+				validation.DanglingRules = append(validation.DanglingRules, pred)
+			}
+		}
+	}
+
+	for _, member := range group.Members {
+		if member.Name == "" || !hasType(member.Types, "User") {
+			validation.MissingMembers = append(validation.MissingMembers, member.Uid)
+		}
+	}
+
+	sort.Strings(validation.DanglingRules)
+	sort.Strings(validation.MissingMembers)
+	validation.Valid = len(validation.DanglingRules) == 0 && len(validation.MissingMembers) == 0
+	return validation, nil
+}
+
+func hasType(types []string, typ string) bool {
+	for _, t := range types {
+		if t == typ {
+			return true
+		}
+	}
+	return false
+}
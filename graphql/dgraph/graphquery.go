@@ -77,7 +77,9 @@ func writeQuery(b *strings.Builder, query *gql.GraphQuery, prefix string, root b
 			prefixAdd = "  "
 		}
 		for _, c := range query.Children {
-			writeQuery(b, c, prefix+prefixAdd, false)
+			// The children of a root query without an attribute are root blocks themselves, so
+			// their order and pagination is written in the root function.
+			writeQuery(b, c, prefix+prefixAdd, root && query.Attr == "")
 		}
 		if query.Attr != "" {
 			x.Check2(b.WriteString(prefix))
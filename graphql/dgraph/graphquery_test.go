@@ -0,0 +1,65 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dgraph
+
+import (
+	"testing"
+
+	"github.com/dgraph-io/dgraph/gql"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAsStringWritesPaginationOfRootBlocksOnce(t *testing.T) {
+	query := &gql.GraphQuery{
+		Children: []*gql.GraphQuery{
+			{
+				Attr: "var",
+				Func: &gql.Function{
+					Name: "eq",
+					Args: []gql.Arg{{Value: "dgraph.xid"}, {Value: `"dev"`}},
+				},
+				Children: []*gql.GraphQuery{{Var: "members", Attr: "~dgraph.user.group"}},
+			},
+			{
+				Attr: "queryUser",
+				Func: &gql.Function{
+					Name: "type",
+					Args: []gql.Arg{{Value: "User"}},
+				},
+				Filter: &gql.FilterTree{
+					Func: &gql.Function{
+						Name: "uid",
+						Args: []gql.Arg{{Value: "members"}},
+					},
+				},
+				Order:    []*pb.Order{{Attr: "dgraph.xid"}},
+				Args:     map[string]string{"first": "2", "offset": "1"},
+				Children: []*gql.GraphQuery{{Attr: "dgraph.xid"}},
+			},
+		},
+	}
+
+	require.Equal(t, `query {
+  var(func: eq(dgraph.xid, "dev")) {
+    members as ~dgraph.user.group
+  }
+  queryUser(func: type(User), orderasc: dgraph.xid, first: 2, offset: 1) @filter(uid(members)) {
+    dgraph.xid
+  }
+}`, AsString(query))
+}
@@ -22,6 +22,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/dgraph-io/dgo/v2"
 	"github.com/dgraph-io/dgo/v2/protos/api"
@@ -41,6 +42,10 @@ const (
             "predicate": "dgraph.graphql.schema",
             "type": "string"
         },
+        {
+            "predicate": "dgraph.graphql.schema_updated_at",
+            "type": "datetime"
+        },
         {
             "predicate": "dgraph.type",
             "type": "string",
@@ -56,6 +61,9 @@ const (
             "fields": [
                 {
                     "name": "dgraph.graphql.schema"
+                },
+                {
+                    "name": "dgraph.graphql.schema_updated_at"
                 }
             ],
             "name": "dgraph.graphql"
@@ -77,6 +85,10 @@ const (
             "predicate": "dgraph.graphql.schema",
             "type": "string"
         },
+        {
+            "predicate": "dgraph.graphql.schema_updated_at",
+            "type": "datetime"
+        },
         {
             "predicate": "dgraph.type",
             "type": "string",
@@ -100,6 +112,9 @@ const (
             "fields": [
                 {
                     "name": "dgraph.graphql.schema"
+                },
+                {
+                    "name": "dgraph.graphql.schema_updated_at"
                 }
             ],
             "name": "dgraph.graphql"
@@ -136,6 +151,10 @@ const (
             "predicate": "dgraph.graphql.schema",
             "type": "string"
         },
+        {
+            "predicate": "dgraph.graphql.schema_updated_at",
+            "type": "datetime"
+        },
         {
             "predicate": "dgraph.type",
             "type": "string",
@@ -162,6 +181,9 @@ const (
             "fields": [
                 {
                     "name": "dgraph.graphql.schema"
+                },
+                {
+                    "name": "dgraph.graphql.schema_updated_at"
                 }
             ],
             "name": "dgraph.graphql"
@@ -206,6 +228,10 @@ const (
             "predicate": "dgraph.graphql.schema",
             "type": "string"
         },
+        {
+            "predicate": "dgraph.graphql.schema_updated_at",
+            "type": "datetime"
+        },
         {
             "predicate": "dgraph.type",
             "type": "string",
@@ -235,6 +261,9 @@ const (
             "fields": [
                 {
                     "name": "dgraph.graphql.schema"
+                },
+                {
+                    "name": "dgraph.graphql.schema_updated_at"
                 }
             ],
             "name": "dgraph.graphql"
@@ -273,6 +302,8 @@ func admin(t *testing.T) {
 	addGQLSchema(t, client)
 	updateSchema(t, client)
 	updateSchemaThroughAdminSchemaEndpt(t, client)
+	forceIncompatibleSchemaUpdate(t)
+	failedDgraphSchemaUpdateReverts(t)
 }
 
 func schemaIsInInitialState(t *testing.T, client *dgo.Dgraph) {
@@ -286,17 +317,131 @@ func addGQLSchema(t *testing.T, client *dgo.Dgraph) {
 	err := addSchema(graphqlAdminTestAdminURL, firstTypes)
 	require.NoError(t, err)
 
+	getSchemaParams := &GraphQLParams{
+		Query: `query {
+			getGQLSchema {
+				schema
+				updatedAt
+			}
+		}`,
+	}
+	gqlResponse := getSchemaParams.ExecuteAsPost(t, graphqlAdminTestAdminURL)
+	requireNoGQLErrors(t, gqlResponse)
+	var getResult struct {
+		GetGQLSchema struct {
+			Schema    string
+			UpdatedAt time.Time
+		}
+	}
+	require.NoError(t, json.Unmarshal(gqlResponse.Data, &getResult))
+	require.Equal(t, firstTypes, getResult.GetGQLSchema.Schema)
+	require.WithinDuration(t, time.Now(), getResult.GetGQLSchema.UpdatedAt, time.Minute)
+
 	resp, err := client.NewReadOnlyTxn().Query(context.Background(), "schema {}")
 	require.NoError(t, err)
 
 	require.JSONEq(t, firstSchema, string(resp.GetJson()))
+	dgraphSchemaHas(t, "A.b", "string", "A")
 
 	introspect(t, firstGQLSchema)
 }
 
+// dgraphSchemaHas checks that the dgraphSchema admin query returns predicate with the type
+// typeName, and that predicate is a field of the Dgraph type dgraphType.
+func dgraphSchemaHas(t *testing.T, predicate, typeName, dgraphType string) {
+	params := &GraphQLParams{
+		Query: `query {
+			dgraphSchema {
+				schema {
+					predicate
+					type
+					index
+				}
+				types {
+					name
+					fields {
+						name
+					}
+				}
+			}
+		}`,
+	}
+	gqlResponse := params.ExecuteAsPost(t, graphqlAdminTestAdminURL)
+	requireNoGQLErrors(t, gqlResponse)
+
+	var result struct {
+		DgraphSchema struct {
+			Schema []struct {
+				Predicate string
+				Type      string
+				Index     bool
+			}
+			Types []struct {
+				Name   string
+				Fields []struct {
+					Name string
+				}
+			}
+		}
+	}
+	require.NoError(t, json.Unmarshal(gqlResponse.Data, &result))
+
+	foundPredicate := false
+	for _, pred := range result.DgraphSchema.Schema {
+		if pred.Predicate == predicate {
+			foundPredicate = true
+			require.Equal(t, typeName, pred.Type)
+			require.False(t, pred.Index)
+		}
+	}
+	require.True(t, foundPredicate, "predicate %s isn't in the Dgraph schema", predicate)
+
+	foundField := false
+	for _, typ := range result.DgraphSchema.Types {
+		if typ.Name != dgraphType {
+			continue
+		}
+		for _, f := range typ.Fields {
+			foundField = foundField || f.Name == predicate
+		}
+	}
+	require.True(t, foundField, "predicate %s isn't a field of type %s", predicate, dgraphType)
+}
+
 func updateSchema(t *testing.T, client *dgo.Dgraph) {
-	err := addSchema(graphqlAdminTestAdminURL, updatedTypes)
-	require.NoError(t, err)
+	update := &GraphQLParams{
+		Query: `mutation updateGQLSchema($sch: String!) {
+			updateGQLSchema(input: { set: { schema: $sch }}) {
+				gqlSchema {
+					schema
+				}
+				diff {
+					addedPredicates
+					removedPredicates
+					modifiedPredicates
+					addedTypes
+					modifiedTypes
+				}
+			}
+		}`,
+		Variables: map[string]interface{}{"sch": updatedTypes},
+	}
+	gqlResponse := update.ExecuteAsPost(t, graphqlAdminTestAdminURL)
+	requireNoGQLErrors(t, gqlResponse)
+	require.JSONEq(t, `{
+		"updateGQLSchema": {
+			"gqlSchema": {
+				"schema": `+jsonString(t, updatedTypes)+`
+			},
+			"diff": {
+				"addedPredicates": ["A.c"],
+				"removedPredicates": [],
+				"modifiedPredicates": [],
+				"addedTypes": [],
+				"modifiedTypes": ["A"]
+			}
+		}
+	}`, string(gqlResponse.Data))
 
 	resp, err := client.NewReadOnlyTxn().Query(context.Background(), "schema {}")
 	require.NoError(t, err)
@@ -306,6 +451,72 @@ func updateSchema(t *testing.T, client *dgo.Dgraph) {
 	introspect(t, updatedGQLSchema)
 }
 
+// forceIncompatibleSchemaUpdate checks that a schema update removing fields is only applied
+// with force.
+func forceIncompatibleSchemaUpdate(t *testing.T) {
+	update := &GraphQLParams{
+		Query: `mutation updateGQLSchema($sch: String!, $force: Boolean) {
+			updateGQLSchema(input: { set: { schema: $sch }, force: $force }) {
+				gqlSchema {
+					schema
+				}
+			}
+		}`,
+		Variables: map[string]interface{}{"sch": firstTypes},
+	}
+	gqlResponse := update.ExecuteAsPost(t, graphqlAdminTestAdminURL)
+	require.Len(t, gqlResponse.Errors, 1)
+	require.Contains(t, gqlResponse.Errors[0].Message, "predicate A.c is removed")
+	require.Contains(t, gqlResponse.Errors[0].Message, "predicate A.d is removed")
+	introspect(t, adminSchemaEndptGQLSchema)
+
+	update.Variables["force"] = true
+	gqlResponse = update.ExecuteAsPost(t, graphqlAdminTestAdminURL)
+	requireNoGQLErrors(t, gqlResponse)
+	introspect(t, firstGQLSchema)
+}
+
+// failedDgraphSchemaUpdateReverts checks that the GraphQL schema isn't updated by
+// updateGQLAndDgraphSchema if the Dgraph schema can't be altered.
+func failedDgraphSchemaUpdateReverts(t *testing.T) {
+	update := &GraphQLParams{
+		Query: `mutation updateGQLAndDgraphSchema($sch: String!, $dgraphSch: String!) {
+			updateGQLAndDgraphSchema(input: { set: { schema: $sch }, dgraphSchema: $dgraphSch }) {
+				gqlSchema {
+					schema
+				}
+			}
+		}`,
+		Variables: map[string]interface{}{
+			"sch": adminSchemaEndptTypes,
+			// Reserved predicates can't be altered, so the alter fails.
+			"dgraphSch": "dgraph.graphql.schema: int .",
+		},
+	}
+	gqlResponse := update.ExecuteAsPost(t, graphqlAdminTestAdminURL)
+	require.Len(t, gqlResponse.Errors, 1)
+	require.Contains(t, gqlResponse.Errors[0].Message,
+		"failed to alter Dgraph schema, so the GraphQL schema wasn't updated")
+
+	getSchemaParams := &GraphQLParams{
+		Query: `query {
+			getGQLSchema {
+				schema
+			}
+		}`,
+	}
+	gqlResponse = getSchemaParams.ExecuteAsPost(t, graphqlAdminTestAdminURL)
+	requireNoGQLErrors(t, gqlResponse)
+	require.JSONEq(t, `{"getGQLSchema": {"schema": `+jsonString(t, firstTypes)+`}}`,
+		string(gqlResponse.Data))
+}
+
+func jsonString(t *testing.T, s string) string {
+	b, err := json.Marshal(s)
+	require.NoError(t, err)
+	return string(b)
+}
+
 func updateSchemaThroughAdminSchemaEndpt(t *testing.T, client *dgo.Dgraph) {
 	err := addSchemaThroughAdminSchemaEndpt(graphqlAdminTestAdminSchemaURL, adminSchemaEndptTypes)
 	require.NoError(t, err)
@@ -378,4 +589,217 @@ func health(t *testing.T) {
 	if diff := cmp.Diff(health, result.Health, opts...); diff != "" {
 		t.Errorf("result mismatch (-want +got):\n%s", diff)
 	}
+
+	// The alpha serving the request also reports the state of ACL, which isn't enabled in this
+	// cluster.
+	queryParams = &GraphQLParams{
+		Query: `query {
+        health {
+          instance
+          acl {
+            enabled
+            lastCacheRefresh
+            userCount
+            groupCount
+          }
+        }
+      }`,
+	}
+	gqlResponse = queryParams.ExecuteAsPost(t, graphqlAdminTestAdminURL)
+	requireNoGQLErrors(t, gqlResponse)
+
+	type aclStatus struct {
+		Enabled          bool
+		LastCacheRefresh int64
+		UserCount        int
+		GroupCount       int
+	}
+	var aclResult struct {
+		Health []struct {
+			Instance string
+			Acl      *aclStatus
+		}
+	}
+	require.NoError(t, json.Unmarshal([]byte(gqlResponse.Data), &aclResult))
+	var aclStatuses []*aclStatus
+	for _, node := range aclResult.Health {
+		if node.Acl != nil {
+			require.Equal(t, "alpha", node.Instance)
+			aclStatuses = append(aclStatuses, node.Acl)
+		}
+	}
+	require.Equal(t, []*aclStatus{{}}, aclStatuses)
+}
+
+// healthFilter checks that the health query only returns the nodes matching its arguments.
+func healthFilter(t *testing.T) {
+	queryParams := &GraphQLParams{
+		Query: `query {
+			all: health {
+				address
+				group
+			}
+			none: health(group: 1000) {
+				address
+			}
+			zeros: health(group: 0, order: {desc: address}) {
+				instance
+				address
+			}
+			first: health(order: {asc: group, then: {asc: address}}, first: 1) {
+				address
+				group
+			}
+		}`,
+	}
+	gqlResponse := queryParams.ExecuteAsPost(t, graphqlAdminTestAdminURL)
+	requireNoGQLErrors(t, gqlResponse)
+
+	type node struct {
+		Instance string
+		Address  string
+		Group    int
+	}
+	var result struct {
+		All   []node
+		None  []node
+		Zeros []node
+		First []node
+	}
+	require.NoError(t, json.Unmarshal(gqlResponse.Data, &result))
+	require.NotEmpty(t, result.All)
+	require.NotNil(t, result.None)
+	require.Empty(t, result.None)
+
+	for i, zero := range result.Zeros {
+		require.Equal(t, "zero", zero.Instance)
+		if i > 0 {
+			require.True(t, result.Zeros[i-1].Address > zero.Address,
+				"zeros should be sorted by descending address")
+		}
+	}
+
+	require.Len(t, result.First, 1)
+	for _, n := range result.All {
+		require.True(t, n.Group > result.First[0].Group ||
+			(n.Group == result.First[0].Group && n.Address >= result.First[0].Address))
+	}
+}
+
+// maintenanceMessage checks that the message set with setMaintenanceMessage is reported in the
+// health of the alpha serving the request until it's cleared.
+func maintenanceMessage(t *testing.T) {
+	setMessage := func(msg interface{}) {
+		params := &GraphQLParams{
+			Query: `mutation setMaintenanceMessage($msg: String) {
+				setMaintenanceMessage(msg: $msg) {
+					response {
+						code
+					}
+				}
+			}`,
+			Variables: map[string]interface{}{"msg": msg},
+		}
+		gqlResponse := params.ExecuteAsPost(t, graphqlAdminTestAdminURL)
+		requireNoGQLErrors(t, gqlResponse)
+		require.JSONEq(t, `{"setMaintenanceMessage":{"response":{"code":"Success"}}}`,
+			string(gqlResponse.Data))
+	}
+	messages := func() []string {
+		params := &GraphQLParams{
+			Query: `query {
+				health {
+					maintenanceMessage
+				}
+			}`,
+		}
+		gqlResponse := params.ExecuteAsPost(t, graphqlAdminTestAdminURL)
+		requireNoGQLErrors(t, gqlResponse)
+
+		var result struct {
+			Health []struct {
+				MaintenanceMessage *string
+			}
+		}
+		require.NoError(t, json.Unmarshal(gqlResponse.Data, &result))
+		var msgs []string
+		for _, node := range result.Health {
+			if node.MaintenanceMessage != nil {
+				msgs = append(msgs, *node.MaintenanceMessage)
+			}
+		}
+		return msgs
+	}
+
+	setMessage("upgrading to the new release")
+	defer setMessage(nil)
+	require.Equal(t, []string{"upgrading to the new release"}, messages())
+
+	setMessage(nil)
+	require.Empty(t, messages())
+}
+
+// exportThroughAdmin starts an export with the GraphQL /admin export mutation and polls the task
+// it returns until the export is done.
+func exportThroughAdmin(t *testing.T) {
+	exportParams := &GraphQLParams{
+		Query: `mutation {
+			export(input: {format: "json"}) {
+				response {
+					code
+					taskId
+				}
+			}
+		}`,
+	}
+	gqlResponse := exportParams.ExecuteAsPost(t, graphqlAdminTestAdminURL)
+	requireNoGQLErrors(t, gqlResponse)
+
+	var exportResult struct {
+		Export struct {
+			Response struct {
+				Code   string
+				TaskId string
+			}
+		}
+	}
+	require.NoError(t, json.Unmarshal(gqlResponse.Data, &exportResult))
+	require.Equal(t, "Success", exportResult.Export.Response.Code)
+	taskId := exportResult.Export.Response.TaskId
+	require.NotEmpty(t, taskId)
+
+	taskParams := &GraphQLParams{
+		Query: `query task($id: ID!) {
+			task(id: $id) {
+				id
+				kind
+				status
+				progress
+				error
+			}
+		}`,
+		Variables: map[string]interface{}{"id": taskId},
+	}
+	type task struct {
+		Id       string
+		Kind     string
+		Status   string
+		Progress float64
+		Error    string
+	}
+	var taskResult struct {
+		Task *task
+	}
+	for i := 0; i < 60; i++ {
+		gqlResponse = taskParams.ExecuteAsPost(t, graphqlAdminTestAdminURL)
+		requireNoGQLErrors(t, gqlResponse)
+		require.NoError(t, json.Unmarshal(gqlResponse.Data, &taskResult))
+		require.NotNil(t, taskResult.Task)
+		if status := taskResult.Task.Status; status != "Queued" && status != "Running" {
+			break
+		}
+		time.Sleep(time.Second)
+	}
+	require.Equal(t, &task{Id: taskId, Kind: "export", Status: "Done", Progress: 100},
+		taskResult.Task)
 }
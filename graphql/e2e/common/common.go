@@ -190,6 +190,9 @@ func RunAll(t *testing.T) {
 	// admin tests
 	t.Run("admin", admin)
 	t.Run("health", health)
+	t.Run("health filter", healthFilter)
+	t.Run("maintenance message", maintenanceMessage)
+	t.Run("export through admin", exportThroughAdmin)
 
 	// schema tests
 	t.Run("graphql descriptions", graphQLDescriptions)
@@ -60,6 +60,10 @@ func TestSchema_WithDgraphDirectives(t *testing.T) {
 			"predicate": "dgraph.graphql.schema",
 			"type": "string"
 		  },
+		  {
+			"predicate": "dgraph.graphql.schema_updated_at",
+			"type": "datetime"
+		  },
 		  {
 			"predicate": "State.name",
 			"type": "string"
@@ -315,6 +319,9 @@ func TestSchema_WithDgraphDirectives(t *testing.T) {
 			"fields": [
 			  {
 				"name": "dgraph.graphql.schema"
+			  },
+			  {
+				"name": "dgraph.graphql.schema_updated_at"
 			  }
 			],
 			"name": "dgraph.graphql"
@@ -243,6 +243,10 @@ func TestSchema_Normal(t *testing.T) {
 			"predicate": "dgraph.graphql.schema",
 			"type": "string"
 		  },
+		  {
+			"predicate": "dgraph.graphql.schema_updated_at",
+			"type": "datetime"
+		  },
 		  {
 			"predicate": "dgraph.type",
 			"type": "string",
@@ -432,6 +436,9 @@ func TestSchema_Normal(t *testing.T) {
 			"fields": [
 			  {
 				"name": "dgraph.graphql.schema"
+			  },
+			  {
+				"name": "dgraph.graphql.schema_updated_at"
 			  }
 			],
 			"name": "dgraph.graphql"
@@ -17,6 +17,7 @@
 package schema_subscribe
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/dgraph-io/dgraph/graphql/e2e/common"
@@ -31,7 +32,7 @@ func TestSchemaSubscribe(t *testing.T) {
 	groupOneServer := "http://localhost:8180/graphql"
 	groupOneAdminServer := "http://localhost:8180/admin"
 	groupTwoServer := "http://localhost:8182/graphql"
-	// groupTwoAdminServer := "http://localhost:8182/admin"
+	groupTwoAdminServer := "http://localhost:8182/admin"
 	groupThreeServer := "http://localhost:8183/graphql"
 	groupThreeAdminServer := "http://localhost:8183/admin"
 
@@ -106,4 +107,44 @@ func TestSchemaSubscribe(t *testing.T) {
 	introspectionResult = introspect.ExecuteAsPost(t, groupThreeServer)
 	require.Nil(t, introspectionResult.Errors)
 	testutil.CompareJSON(t, expectedResult, string(introspectionResult.Data))
+
+	// All the alphas report that they serve the updated schema.
+	version, updatedAt := servedSchema(t, groupOneAdminServer)
+	require.NotEmpty(t, version)
+	require.NotEmpty(t, updatedAt)
+	for _, adminServer := range []string{groupTwoAdminServer, groupThreeAdminServer} {
+		otherVersion, otherUpdatedAt := servedSchema(t, adminServer)
+		require.Equal(t, version, otherVersion)
+		require.Equal(t, updatedAt, otherUpdatedAt)
+	}
+}
+
+// servedSchema returns the version and update time of the GraphQL schema the alpha serving
+// adminURL reports in its health.
+func servedSchema(t *testing.T, adminURL string) (string, string) {
+	health := &common.GraphQLParams{
+		Query: `query {
+			health {
+				graphqlSchemaVersion
+				graphqlSchemaUpdatedAt
+			}
+		}`,
+	}
+	healthResult := health.ExecuteAsPost(t, adminURL)
+	require.Nil(t, healthResult.Errors)
+
+	var result struct {
+		Health []struct {
+			GraphqlSchemaVersion   string
+			GraphqlSchemaUpdatedAt string
+		}
+	}
+	require.NoError(t, json.Unmarshal(healthResult.Data, &result))
+	for _, node := range result.Health {
+		if node.GraphqlSchemaVersion != "" {
+			return node.GraphqlSchemaVersion, node.GraphqlSchemaUpdatedAt
+		}
+	}
+	t.Fatalf("no alpha reported the GraphQL schema it serves through %s", adminURL)
+	return "", ""
 }
@@ -94,6 +94,15 @@ func NewDeleteRewriter() MutationRewriter {
 	return &deleteRewriter{}
 }
 
+// MutatedNodesQuery returns a query that finds the nodes the update or delete mutation m applies
+// to, in the same way as the upsert query m is rewritten into. Its block is named after m and
+// returns the uid of each node.
+func MutatedNodesQuery(m schema.Mutation) *gql.GraphQuery {
+	qry := rewriteUpsertQueryFromMutation(m)
+	qry.Var = ""
+	return qry
+}
+
 // Rewrite takes a GraphQL schema.Mutation add and builds a Dgraph upsert mutation.
 // m must have a single argument called 'input' that carries the mutation data.
 //
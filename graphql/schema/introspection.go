@@ -2,9 +2,11 @@ package schema
 
 import (
 	"bytes"
+	"container/list"
 	"encoding/json"
 	"errors"
 	"strconv"
+	"sync"
 
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/introspection"
@@ -48,13 +50,97 @@ func Introspect(q Query) (json.RawMessage, error) {
 			"this indicates bug (Please let us know : https://github.com/dgraph-io/dgraph/issues)")
 	}
 
-	reqCtx := &requestContext{
-		RawQuery:  op.query,
-		Variables: op.vars,
-		Doc:       op.doc,
+	key, err := introspectionKey(op, qu)
+	if err != nil {
+		return nil, err
 	}
-	ec := executionContext{reqCtx, sch.schema, new(bytes.Buffer)}
-	return ec.handleQuery(qu.sel), nil
+	return sch.introspection.get(key, func() json.RawMessage {
+		reqCtx := &requestContext{
+			RawQuery:  op.query,
+			Variables: op.vars,
+			Doc:       op.doc,
+		}
+		ec := executionContext{reqCtx, sch.schema, new(bytes.Buffer)}
+		return ec.handleQuery(qu.sel)
+	}), nil
+}
+
+// maxIntrospectionResults is the most introspection results cached for a schema. The cache is
+// keyed on the query text sent by clients, so without a bound, clients could grow it without
+// limit by sending queries that differ only slightly.
+const maxIntrospectionResults = 100
+
+// introspectionCache holds the results of the introspection queries run against a schema. A
+// schema is never changed after it's built, so the results stay valid for as long as the schema
+// is served, unless the cache is invalidated. Only the maxIntrospectionResults most recently used
+// results are kept.
+type introspectionCache struct {
+	sync.Mutex
+	results map[string]*list.Element
+	// lru orders the cached results from most to least recently used.
+	lru *list.List
+	// version is bumped whenever the cache is invalidated.
+	version uint64
+	// builds counts the introspection results that had to be computed, rather than read from
+	// the cache.
+	builds uint64
+}
+
+type introspectionResult struct {
+	key    string
+	result json.RawMessage
+}
+
+func (c *introspectionCache) get(key string, build func() json.RawMessage) json.RawMessage {
+	c.Lock()
+	defer c.Unlock()
+	if elem, ok := c.results[key]; ok {
+		c.lru.MoveToFront(elem)
+		return elem.Value.(*introspectionResult).result
+	}
+	if c.results == nil {
+		c.results = make(map[string]*list.Element)
+		c.lru = list.New()
+	}
+	res := build()
+	c.results[key] = c.lru.PushFront(&introspectionResult{key: key, result: res})
+	if c.lru.Len() > maxIntrospectionResults {
+		oldest := c.lru.Remove(c.lru.Back()).(*introspectionResult)
+		delete(c.results, oldest.key)
+	}
+	c.builds++
+	return res
+}
+
+func (c *introspectionCache) invalidate() uint64 {
+	c.Lock()
+	defer c.Unlock()
+	c.results = nil
+	c.lru = nil
+	c.version++
+	return c.version
+}
+
+// introspectionKey identifies the result of introspection query q. The same field of the same
+// operation gives the same result, as long as it's run with the same variables.
+func introspectionKey(op *operation, q *query) (string, error) {
+	vars, err := json.Marshal(op.vars)
+	if err != nil {
+		return "", err
+	}
+	return op.query + "\x00" + op.op.Name + "\x00" + q.ResponseName() + "\x00" +
+		string(vars), nil
+}
+
+// InvalidateIntrospection drops the cached introspection results of s, so that the next
+// introspection queries are answered by walking the schema again. It returns the version of the
+// cache after the invalidation.
+func InvalidateIntrospection(s Schema) uint64 {
+	sch, ok := s.(*schema)
+	if !ok {
+		return 0
+	}
+	return sch.introspection.invalidate()
 }
 
 type requestContext struct {
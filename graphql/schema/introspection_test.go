@@ -1,6 +1,7 @@
 package schema
 
 import (
+	"fmt"
 	"io/ioutil"
 	"path/filepath"
 	"testing"
@@ -324,3 +325,55 @@ func TestFullIntrospectionQuery(t *testing.T) {
 	require.NoError(t, err)
 	testutil.CompareJSON(t, string(expectedBuf), string(resp))
 }
+
+func TestIntrospectionCache(t *testing.T) {
+	sch := &schema{schema: gqlparser.MustLoadSchema(
+		&ast.Source{Name: "schema.graphql", Input: complexSchema})}
+
+	introspect := func() string {
+		op, err := sch.Operation(&Request{Query: introspectionQuery})
+		require.NoError(t, err)
+		resp, err := Introspect(op.Queries()[0])
+		require.NoError(t, err)
+		return string(resp)
+	}
+
+	first := introspect()
+	require.Equal(t, uint64(1), sch.introspection.builds)
+
+	// Without a schema change, the second query is answered from the cache.
+	testutil.CompareJSON(t, first, introspect())
+	require.Equal(t, uint64(1), sch.introspection.builds)
+
+	require.Equal(t, uint64(1), InvalidateIntrospection(sch))
+	testutil.CompareJSON(t, first, introspect())
+	require.Equal(t, uint64(2), sch.introspection.builds)
+}
+
+func TestIntrospectionCacheIsBounded(t *testing.T) {
+	sch := &schema{schema: gqlparser.MustLoadSchema(
+		&ast.Source{Name: "schema.graphql", Input: complexSchema})}
+
+	introspect := func(alias string) {
+		op, err := sch.Operation(&Request{
+			Query: fmt.Sprintf(`query { %s: __type(name: "TestType") { name } }`, alias),
+		})
+		require.NoError(t, err)
+		_, err = Introspect(op.Queries()[0])
+		require.NoError(t, err)
+	}
+
+	// Each alias is a different query, so each one is cached separately.
+	for i := 0; i <= maxIntrospectionResults; i++ {
+		introspect(fmt.Sprintf("t%d", i))
+	}
+	require.Len(t, sch.introspection.results, maxIntrospectionResults)
+	require.Equal(t, uint64(maxIntrospectionResults+1), sch.introspection.builds)
+
+	// The least recently used result was dropped to make room, and the most recent one wasn't.
+	introspect(fmt.Sprintf("t%d", maxIntrospectionResults))
+	require.Equal(t, uint64(maxIntrospectionResults+1), sch.introspection.builds)
+	introspect("t0")
+	require.Equal(t, uint64(maxIntrospectionResults+2), sch.introspection.builds)
+	require.Len(t, sch.introspection.results, maxIntrospectionResults)
+}
@@ -159,6 +159,8 @@ type schema struct {
 	mutatedType map[string]*astType
 	// Map from typename to ast.Definition
 	typeNameAst map[string][]*ast.Definition
+	// introspection caches the results of the introspection queries run against the schema.
+	introspection introspectionCache
 }
 
 type operation struct {
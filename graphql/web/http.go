@@ -104,6 +104,7 @@ func (gh *graphqlHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 
 	ctx = x.AttachAccessJwt(ctx, r)
+	ctx = x.AttachRemoteAddr(ctx, r)
 
 	var res *schema.Response
 	gqlReq, err := getRequest(ctx, r)
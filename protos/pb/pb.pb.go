@@ -4226,10 +4226,12 @@ func (m *BackupRequest) GetPredicates() []string {
 }
 
 type ExportRequest struct {
-	GroupId              uint32   `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	ReadTs               uint64   `protobuf:"varint,2,opt,name=read_ts,json=readTs,proto3" json:"read_ts,omitempty"`
-	UnixTs               int64    `protobuf:"varint,3,opt,name=unix_ts,json=unixTs,proto3" json:"unix_ts,omitempty"`
-	Format               string   `protobuf:"bytes,4,opt,name=format,proto3" json:"format,omitempty"`
+	GroupId uint32 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	ReadTs  uint64 `protobuf:"varint,2,opt,name=read_ts,json=readTs,proto3" json:"read_ts,omitempty"`
+	UnixTs  int64  `protobuf:"varint,3,opt,name=unix_ts,json=unixTs,proto3" json:"unix_ts,omitempty"`
+	Format  string `protobuf:"bytes,4,opt,name=format,proto3" json:"format,omitempty"`
+	// Directory to write the export to, instead of the --export directory.
+	Destination          string   `protobuf:"bytes,5,opt,name=destination,proto3" json:"destination,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -4296,6 +4298,13 @@ func (m *ExportRequest) GetFormat() string {
 	return ""
 }
 
+func (m *ExportRequest) GetDestination() string {
+	if m != nil {
+		return m.Destination
+	}
+	return ""
+}
+
 // A key stored in the format used for writing backups.
 type BackupKey struct {
 	Type                 BackupKey_KeyType `protobuf:"varint,1,opt,name=type,proto3,enum=pb.BackupKey_KeyType" json:"type,omitempty"`
@@ -4533,264 +4542,264 @@ func init() {
 func init() { proto.RegisterFile("pb.proto", fileDescriptor_f80abaa17e25ccc8) }
 
 var fileDescriptor_f80abaa17e25ccc8 = []byte{
-	// 4111 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x3a, 0x3b, 0x70, 0x1c, 0x47,
-	0x76, 0x98, 0xfd, 0xce, 0xbc, 0xdd, 0x05, 0x57, 0x23, 0x1e, 0xb5, 0x82, 0x74, 0x24, 0x34, 0xfa,
-	0x41, 0xe4, 0x11, 0xa4, 0x20, 0xb9, 0x7c, 0x52, 0x95, 0x03, 0x10, 0x58, 0x52, 0x10, 0xc1, 0x05,
-	0xae, 0x77, 0x41, 0xf9, 0x14, 0x78, 0xab, 0x31, 0xd3, 0x00, 0xe6, 0x30, 0x3b, 0x33, 0x9e, 0x9e,
-	0x85, 0x17, 0xca, 0x5c, 0x2e, 0x3b, 0xb2, 0x23, 0x27, 0x17, 0xd9, 0x8e, 0x9d, 0xb8, 0xca, 0x91,
-	0xcb, 0xae, 0x72, 0xe4, 0xc0, 0x76, 0xe4, 0xdc, 0x81, 0x5d, 0xb2, 0x33, 0x87, 0xae, 0x72, 0xec,
-	0x7a, 0xaf, 0x7b, 0x7e, 0xcb, 0x25, 0x79, 0xba, 0xaa, 0x8b, 0xb6, 0xdf, 0xeb, 0xd7, 0xbf, 0xf7,
-	0x7f, 0x6f, 0x16, 0xcc, 0xf8, 0x74, 0x3b, 0x4e, 0xa2, 0x34, 0xb2, 0x6b, 0xf1, 0xe9, 0x86, 0xc5,
-	0x63, 0x5f, 0x81, 0x1b, 0x1f, 0x9f, 0xfb, 0xe9, 0xc5, 0xfc, 0x74, 0xdb, 0x8d, 0x66, 0x0f, 0xbc,
-	0xf3, 0x84, 0xc7, 0x17, 0xf7, 0xfd, 0xe8, 0xc1, 0x29, 0xf7, 0xce, 0x45, 0xf2, 0x20, 0x3e, 0x7d,
-	0x90, 0xad, 0x73, 0x36, 0xa0, 0x71, 0xe8, 0xcb, 0xd4, 0xb6, 0xa1, 0x31, 0xf7, 0x3d, 0x39, 0x30,
-	0x36, 0xeb, 0x5b, 0x2d, 0x46, 0x63, 0xe7, 0x19, 0x58, 0x13, 0x2e, 0x2f, 0x9f, 0xf3, 0x60, 0x2e,
-	0xec, 0x3e, 0xd4, 0xaf, 0x78, 0x30, 0x30, 0x36, 0x8d, 0xad, 0x2e, 0xc3, 0xa1, 0xbd, 0x0d, 0xe6,
-	0x15, 0x0f, 0xa6, 0xe9, 0x75, 0x2c, 0x06, 0xb5, 0x4d, 0x63, 0x6b, 0x7d, 0xe7, 0xcd, 0xed, 0xf8,
-	0x74, 0xfb, 0x38, 0x92, 0xa9, 0x1f, 0x9e, 0x6f, 0x3f, 0xe7, 0xc1, 0xe4, 0x3a, 0x16, 0xac, 0x7d,
-	0xa5, 0x06, 0xce, 0x11, 0x74, 0xc6, 0x89, 0xfb, 0x78, 0x1e, 0xba, 0xa9, 0x1f, 0x85, 0x78, 0x62,
-	0xc8, 0x67, 0x82, 0x76, 0xb4, 0x18, 0x8d, 0x11, 0xc7, 0x93, 0x73, 0x39, 0xa8, 0x6f, 0xd6, 0x11,
-	0x87, 0x63, 0x7b, 0x00, 0x6d, 0x5f, 0xee, 0x45, 0xf3, 0x30, 0x1d, 0x34, 0x36, 0x8d, 0x2d, 0x93,
-	0x65, 0xa0, 0xf3, 0x97, 0x75, 0x68, 0xfe, 0x6c, 0x2e, 0x92, 0x6b, 0x5a, 0x97, 0xa6, 0x49, 0xb6,
-	0x17, 0x8e, 0xed, 0x9b, 0xd0, 0x0c, 0x78, 0x78, 0x2e, 0x07, 0x35, 0xda, 0x4c, 0x01, 0xf6, 0x3b,
-	0x60, 0xf1, 0xb3, 0x54, 0x24, 0xd3, 0xb9, 0xef, 0x0d, 0xea, 0x9b, 0xc6, 0x56, 0x8b, 0x99, 0x84,
-	0x38, 0xf1, 0x3d, 0xfb, 0x6d, 0x30, 0xbd, 0x68, 0xea, 0x96, 0xcf, 0xf2, 0x22, 0x3a, 0xcb, 0x7e,
-	0x1f, 0xcc, 0xb9, 0xef, 0x4d, 0x03, 0x5f, 0xa6, 0x83, 0xe6, 0xa6, 0xb1, 0xd5, 0xd9, 0x31, 0xf1,
-	0xb1, 0xc8, 0x3b, 0xd6, 0x9e, 0xfb, 0x1e, 0x31, 0xf1, 0x2e, 0x98, 0x32, 0x71, 0xa7, 0x67, 0xf3,
-	0xd0, 0x1d, 0xb4, 0x88, 0xe8, 0x06, 0x12, 0x95, 0x5e, 0xcd, 0xda, 0x52, 0x01, 0xf8, 0xac, 0x44,
-	0x5c, 0x89, 0x44, 0x8a, 0x41, 0x5b, 0x1d, 0xa5, 0x41, 0xfb, 0x21, 0x74, 0xce, 0xb8, 0x2b, 0xd2,
-	0x69, 0xcc, 0x13, 0x3e, 0x1b, 0x98, 0xc5, 0x46, 0x8f, 0x11, 0x7d, 0x8c, 0x58, 0xc9, 0xe0, 0x2c,
-	0x07, 0xec, 0xcf, 0xa0, 0x47, 0x90, 0x9c, 0x9e, 0xf9, 0x41, 0x2a, 0x92, 0x81, 0x45, 0x6b, 0xd6,
-	0x69, 0x0d, 0x61, 0x26, 0x89, 0x10, 0xac, 0xab, 0x88, 0x14, 0xc6, 0xfe, 0x31, 0x80, 0x58, 0xc4,
-	0x3c, 0xf4, 0xa6, 0x3c, 0x08, 0x06, 0x40, 0x77, 0xb0, 0x14, 0x66, 0x37, 0x08, 0xec, 0xb7, 0xf0,
-	0x7e, 0xdc, 0x9b, 0xa6, 0x72, 0xd0, 0xdb, 0x34, 0xb6, 0x1a, 0xac, 0x85, 0xe0, 0x44, 0x22, 0x5f,
-	0x5d, 0xee, 0x5e, 0x88, 0xc1, 0xfa, 0xa6, 0xb1, 0xd5, 0x64, 0x0a, 0x40, 0xec, 0x99, 0x9f, 0xc8,
-	0x74, 0x70, 0x43, 0x61, 0x09, 0x70, 0x76, 0xc0, 0x22, 0xed, 0x21, 0xee, 0x7c, 0x08, 0xad, 0x2b,
-	0x04, 0x94, 0x92, 0x75, 0x76, 0x7a, 0x78, 0xbd, 0x5c, 0xc1, 0x98, 0x9e, 0x74, 0x6e, 0x83, 0x79,
-	0xc8, 0xc3, 0xf3, 0x4c, 0x2b, 0x51, 0x6c, 0xb4, 0xc0, 0x62, 0x34, 0x76, 0x7e, 0x59, 0x83, 0x16,
-	0x13, 0x72, 0x1e, 0xa4, 0xf6, 0xc7, 0x00, 0x28, 0x94, 0x19, 0x4f, 0x13, 0x7f, 0xa1, 0x77, 0x2d,
-	0xc4, 0x62, 0xcd, 0x7d, 0xef, 0x19, 0x4d, 0xd9, 0x0f, 0xa1, 0x4b, 0xbb, 0x67, 0xa4, 0xb5, 0xe2,
-	0x02, 0xf9, 0xfd, 0x58, 0x87, 0x48, 0xf4, 0x8a, 0x5b, 0xd0, 0x22, 0x3d, 0x50, 0xba, 0xd8, 0x63,
-	0x1a, 0xb2, 0x3f, 0x84, 0x75, 0x3f, 0x4c, 0x51, 0x4e, 0x6e, 0x3a, 0xf5, 0x84, 0xcc, 0x14, 0xa5,
-	0x97, 0x63, 0xf7, 0x85, 0x4c, 0xed, 0x4f, 0x41, 0x31, 0x3b, 0x3b, 0xb0, 0x49, 0x07, 0xae, 0xe7,
-	0x42, 0x94, 0xea, 0x44, 0xa2, 0xd1, 0x27, 0xde, 0x87, 0x0e, 0xbe, 0x2f, 0x5b, 0xd1, 0xa2, 0x15,
-	0x5d, 0x7a, 0x8d, 0x66, 0x07, 0x03, 0x24, 0xd0, 0xe4, 0xc8, 0x1a, 0x54, 0x46, 0xa5, 0x3c, 0x34,
-	0x76, 0x86, 0xd0, 0x3c, 0x4a, 0x3c, 0x91, 0xac, 0xb4, 0x07, 0x1b, 0x1a, 0x9e, 0x90, 0x2e, 0x99,
-	0xaa, 0xc9, 0x68, 0x5c, 0xd8, 0x48, 0xbd, 0x64, 0x23, 0xce, 0x5f, 0x18, 0xd0, 0x19, 0x47, 0x49,
-	0xfa, 0x4c, 0x48, 0xc9, 0xcf, 0x85, 0x7d, 0x07, 0x9a, 0x11, 0x6e, 0xab, 0x39, 0x6c, 0xe1, 0x9d,
-	0xe8, 0x1c, 0xa6, 0xf0, 0x4b, 0x72, 0xa8, 0xbd, 0x5c, 0x0e, 0xa8, 0x3b, 0x64, 0x5d, 0x75, 0xad,
-	0x3b, 0x64, 0x5b, 0xb7, 0xa0, 0x15, 0x9d, 0x9d, 0x49, 0xa1, 0x78, 0xd9, 0x64, 0x1a, 0x7a, 0xa9,
-	0x0a, 0x3a, 0xbf, 0x05, 0x80, 0xf7, 0xfb, 0x81, 0x5a, 0xe0, 0x5c, 0x40, 0x87, 0xf1, 0xb3, 0x74,
-	0x2f, 0x0a, 0x53, 0xb1, 0x48, 0xed, 0x75, 0xa8, 0xf9, 0x1e, 0xb1, 0xa8, 0xc5, 0x6a, 0xbe, 0x87,
-	0x97, 0x3b, 0x4f, 0xa2, 0x79, 0x4c, 0x1c, 0xea, 0x31, 0x05, 0x10, 0x2b, 0x3d, 0x2f, 0xa1, 0x1b,
-	0x23, 0x2b, 0x3d, 0x2f, 0xb1, 0xef, 0x40, 0x47, 0x86, 0x3c, 0x96, 0x17, 0x51, 0x8a, 0x97, 0x6b,
-	0xd0, 0xe5, 0x20, 0x43, 0x4d, 0xa4, 0xf3, 0x3f, 0x06, 0xb4, 0x9e, 0x89, 0xd9, 0xa9, 0x48, 0x5e,
-	0x38, 0xe5, 0x6d, 0x30, 0x69, 0xe3, 0xa9, 0xef, 0xe9, 0x83, 0xda, 0x04, 0x1f, 0x78, 0x2b, 0x8f,
-	0xba, 0x05, 0xad, 0x40, 0x70, 0x64, 0xbe, 0xd2, 0x33, 0x0d, 0x21, 0x6f, 0xf8, 0x6c, 0xea, 0x09,
-	0xee, 0x91, 0x3b, 0x32, 0x59, 0x8b, 0xcf, 0xf6, 0x05, 0xf7, 0xf0, 0x6e, 0x01, 0x97, 0xe9, 0x74,
-	0x1e, 0x7b, 0x3c, 0x15, 0xe4, 0x86, 0x1a, 0xa8, 0x38, 0x32, 0x3d, 0x21, 0x8c, 0x7d, 0x17, 0xde,
-	0x70, 0x83, 0xb9, 0x44, 0x1f, 0xe8, 0x87, 0x67, 0xd1, 0x34, 0x0a, 0x83, 0x6b, 0xe2, 0xaf, 0xc9,
-	0x6e, 0xe8, 0x89, 0x83, 0xf0, 0x2c, 0x3a, 0x0a, 0x83, 0x6b, 0xfb, 0x03, 0x58, 0x3f, 0x8b, 0x12,
-	0x57, 0x4c, 0xf3, 0x2b, 0xaf, 0x13, 0x61, 0x97, 0xb0, 0x4f, 0xd4, 0xbd, 0x9d, 0xbf, 0xab, 0x41,
-	0x93, 0xc6, 0xf6, 0x43, 0x68, 0xcf, 0xe8, 0xd9, 0x99, 0x8d, 0xdf, 0x42, 0x39, 0xd0, 0xdc, 0xb6,
-	0xe2, 0x87, 0x1c, 0x86, 0x69, 0x72, 0xcd, 0x32, 0x32, 0x5c, 0x91, 0xf2, 0xd3, 0x40, 0xa4, 0x52,
-	0xeb, 0x4d, 0x69, 0xc5, 0x44, 0x4d, 0xe8, 0x15, 0x9a, 0x6c, 0x99, 0xf9, 0xf5, 0x65, 0xe6, 0xdb,
-	0x1b, 0x60, 0xba, 0x17, 0xc2, 0xbd, 0x94, 0xf3, 0x99, 0x16, 0x4d, 0x0e, 0x6f, 0x3c, 0x86, 0x6e,
-	0xf9, 0x1e, 0x18, 0xd5, 0x2e, 0xc5, 0x35, 0x89, 0xa7, 0xc1, 0x70, 0x68, 0x6f, 0x42, 0x93, 0xfc,
-	0x00, 0x09, 0xa7, 0xb3, 0x03, 0x78, 0x1d, 0xb5, 0x84, 0xa9, 0x89, 0x2f, 0x6b, 0x3f, 0x35, 0x70,
-	0x9f, 0xf2, 0xed, 0xca, 0xfb, 0x58, 0x2f, 0xdf, 0x47, 0x2d, 0x29, 0xed, 0xe3, 0x44, 0xd0, 0x3e,
-	0xf4, 0x5d, 0x11, 0x4a, 0x8a, 0x7d, 0x73, 0x29, 0x72, 0x9b, 0xc5, 0x31, 0x3e, 0x65, 0xc6, 0x17,
-	0xa3, 0xc8, 0x13, 0x92, 0xf6, 0x69, 0xb0, 0x1c, 0xc6, 0x39, 0xb1, 0x88, 0xfd, 0xe4, 0x7a, 0xa2,
-	0x98, 0x50, 0x67, 0x39, 0x8c, 0xc1, 0x45, 0x84, 0x78, 0x98, 0x97, 0xc5, 0x31, 0x0d, 0x3a, 0x7f,
-	0x55, 0x87, 0xee, 0xb7, 0x22, 0x89, 0x8e, 0x93, 0x28, 0x8e, 0x24, 0x0f, 0xec, 0xdd, 0x2a, 0x3b,
-	0x95, 0xd8, 0x36, 0xf1, 0xb6, 0x65, 0xb2, 0xed, 0x71, 0xce, 0x5f, 0x25, 0x8e, 0x32, 0xc3, 0x1d,
-	0x68, 0x29, 0x71, 0xae, 0xe0, 0x99, 0x9e, 0x41, 0x1a, 0x25, 0x40, 0xba, 0x6b, 0x95, 0x1f, 0x7a,
-	0xc6, 0xbe, 0x0d, 0x30, 0xe3, 0x8b, 0x43, 0xc1, 0xa5, 0x38, 0xf0, 0x32, 0xab, 0x2a, 0x30, 0x9a,
-	0x1b, 0x93, 0x45, 0x38, 0x91, 0xa4, 0xf4, 0x8a, 0x1b, 0x04, 0xdb, 0xef, 0x82, 0x35, 0xe3, 0x0b,
-	0x34, 0xef, 0x03, 0x4f, 0x2b, 0x7d, 0x81, 0xb0, 0xdf, 0x83, 0x7a, 0xba, 0x08, 0xc9, 0x57, 0x62,
-	0x28, 0xc5, 0x3c, 0x69, 0xb2, 0x08, 0xb5, 0x23, 0x60, 0x38, 0x97, 0x49, 0xd0, 0x2c, 0x24, 0xd8,
-	0x87, 0xba, 0xeb, 0x7b, 0x14, 0x4b, 0x2d, 0x86, 0x43, 0xfb, 0x43, 0x68, 0x07, 0x4a, 0x5a, 0x14,
-	0x2f, 0x3b, 0x3b, 0x1d, 0xe5, 0x66, 0x08, 0xc5, 0xb2, 0xb9, 0x8d, 0xdf, 0x81, 0x1b, 0x4b, 0xec,
-	0x2a, 0xeb, 0x47, 0x4f, 0xed, 0x7e, 0xb3, 0xac, 0x1f, 0x8d, 0xb2, 0x4e, 0xfc, 0x47, 0x1d, 0x6e,
-	0x68, 0x25, 0xbd, 0xf0, 0xe3, 0x71, 0x8a, 0x46, 0x3b, 0x80, 0x36, 0xf9, 0x4a, 0xad, 0x1f, 0x0d,
-	0x96, 0x81, 0xf6, 0x6f, 0x43, 0x8b, 0x8c, 0x33, 0xb3, 0x9f, 0x3b, 0x05, 0xf3, 0xf3, 0xe5, 0xca,
-	0x9e, 0xb4, 0xe4, 0x34, 0xb9, 0xfd, 0x39, 0x34, 0xbf, 0x13, 0x49, 0xa4, 0x7c, 0x7f, 0x67, 0xe7,
-	0xf6, 0xaa, 0x75, 0xa8, 0x02, 0x7a, 0x99, 0x22, 0xfe, 0x0d, 0xca, 0xe8, 0x03, 0xf4, 0xf6, 0xb3,
-	0xe8, 0x4a, 0x78, 0x83, 0x36, 0xdd, 0xa8, 0xac, 0x46, 0xd9, 0x54, 0x26, 0x14, 0x73, 0xa5, 0x50,
-	0xac, 0x57, 0x08, 0x65, 0x1f, 0x3a, 0x25, 0x2e, 0xac, 0x10, 0xc8, 0x9d, 0xaa, 0xc1, 0x5a, 0xb9,
-	0x1f, 0x2a, 0xdb, 0xfd, 0x3e, 0x40, 0xc1, 0x93, 0x5f, 0xd7, 0x7b, 0x38, 0x7f, 0x68, 0xc0, 0x8d,
-	0xbd, 0x28, 0x0c, 0x05, 0xe5, 0x84, 0x4a, 0xc2, 0x85, 0x11, 0x19, 0x2f, 0x35, 0xa2, 0x4f, 0xa0,
-	0x29, 0x91, 0x58, 0xef, 0xfe, 0xe6, 0x0a, 0x91, 0x31, 0x45, 0x81, 0x5e, 0x72, 0xc6, 0x17, 0xd3,
-	0x58, 0x84, 0x9e, 0x1f, 0x9e, 0x67, 0x5e, 0x72, 0xc6, 0x17, 0xc7, 0x0a, 0xe3, 0xfc, 0xa3, 0x01,
-	0xf0, 0x95, 0xe0, 0x41, 0x7a, 0x81, 0xde, 0x1e, 0xe5, 0xe6, 0x87, 0x32, 0xe5, 0xa1, 0x9b, 0x65,
-	0xe4, 0x39, 0x8c, 0xca, 0x87, 0xb1, 0x48, 0x48, 0xe5, 0x84, 0x2c, 0x96, 0x81, 0x18, 0x9d, 0xf0,
-	0xb8, 0xb9, 0xd4, 0x31, 0x4b, 0x43, 0x45, 0x28, 0x6d, 0x10, 0x5a, 0x87, 0xd2, 0x01, 0xb4, 0x31,
-	0xc3, 0xf5, 0xa3, 0x90, 0x54, 0xc3, 0x62, 0x19, 0x88, 0xfb, 0xcc, 0xe3, 0xd4, 0x9f, 0xa9, 0x78,
-	0x55, 0x67, 0x1a, 0xc2, 0x5b, 0x61, 0xe4, 0x1a, 0xba, 0x17, 0x11, 0x19, 0x6f, 0x9d, 0xe5, 0xb0,
-	0xf3, 0x0f, 0x06, 0xb4, 0x94, 0x03, 0xa9, 0xc4, 0x54, 0xa3, 0x1a, 0x53, 0xdf, 0x05, 0x2b, 0x4e,
-	0x84, 0xe7, 0xbb, 0x19, 0xdb, 0x2c, 0x56, 0x20, 0x28, 0x6b, 0xc5, 0x48, 0x46, 0xd7, 0x37, 0x99,
-	0x02, 0x10, 0x2b, 0x63, 0xee, 0x0a, 0x7d, 0xa4, 0x02, 0xf0, 0x8e, 0x4a, 0x09, 0x49, 0xf9, 0x4c,
-	0xa6, 0x21, 0xac, 0x28, 0x28, 0x4b, 0xa1, 0x38, 0x6a, 0xd1, 0x94, 0x89, 0x08, 0x0a, 0xa0, 0x6f,
-	0x41, 0x1b, 0x89, 0xd0, 0xb3, 0x82, 0x4a, 0x61, 0x10, 0x9c, 0x48, 0xe7, 0xaf, 0x6b, 0xd0, 0xdd,
-	0xf7, 0x13, 0xe1, 0xa6, 0xc2, 0x1b, 0x7a, 0xe7, 0xb4, 0xbd, 0x08, 0x53, 0x3f, 0xbd, 0xd6, 0xb9,
-	0x82, 0x86, 0xf2, 0x54, 0xae, 0x56, 0x2d, 0x6d, 0x94, 0x96, 0xd5, 0xa9, 0x1a, 0x53, 0x80, 0xbd,
-	0x03, 0xa0, 0x92, 0x5c, 0xaa, 0xc8, 0x1a, 0x2f, 0xaf, 0xc8, 0x2c, 0x22, 0xc3, 0x21, 0x72, 0x4e,
-	0xad, 0xf1, 0x55, 0x1e, 0xd1, 0xa2, 0x72, 0x6d, 0x8e, 0x96, 0x4c, 0xb9, 0xe1, 0xa9, 0x08, 0x48,
-	0x24, 0x94, 0x1b, 0x9e, 0x8a, 0x20, 0xcf, 0xc8, 0xdb, 0xea, 0x3a, 0x38, 0xb6, 0xdf, 0x87, 0x5a,
-	0x14, 0x13, 0x57, 0xf4, 0x81, 0xe5, 0x87, 0x6d, 0x1f, 0xc5, 0xac, 0x16, 0xc5, 0xa8, 0xdf, 0xaa,
-	0xfc, 0x18, 0x58, 0xda, 0xba, 0xd1, 0x0b, 0x53, 0x32, 0xcc, 0xf4, 0x8c, 0x73, 0x0b, 0x6a, 0x47,
-	0xb1, 0xdd, 0x86, 0xfa, 0x78, 0x38, 0xe9, 0xaf, 0xe1, 0x60, 0x7f, 0x78, 0xd8, 0x37, 0x9c, 0xef,
-	0x6b, 0x60, 0x3d, 0x9b, 0xa7, 0x1c, 0xad, 0x45, 0xbe, 0x4a, 0xda, 0x6f, 0x83, 0x29, 0x53, 0x9e,
-	0x50, 0x24, 0x53, 0x7e, 0xb5, 0x4d, 0xf0, 0x44, 0xda, 0x1f, 0x41, 0x53, 0x78, 0xe7, 0x22, 0x73,
-	0x77, 0xfd, 0xe5, 0x7b, 0x32, 0x35, 0x6d, 0x6f, 0x41, 0x4b, 0xba, 0x17, 0x62, 0xc6, 0x07, 0x8d,
-	0x82, 0x70, 0x4c, 0x18, 0x95, 0x40, 0x31, 0x3d, 0x6f, 0x7f, 0x00, 0x4d, 0xe4, 0xb4, 0xd4, 0xa9,
-	0x3a, 0x25, 0xf7, 0xc8, 0x54, 0x4d, 0xa6, 0x26, 0xed, 0xfb, 0xd0, 0xf6, 0x92, 0x28, 0x9e, 0x46,
-	0x31, 0xf1, 0x6c, 0x7d, 0xe7, 0x26, 0x59, 0x6d, 0xf6, 0x9a, 0xed, 0xfd, 0x24, 0x8a, 0x8f, 0x62,
-	0xd6, 0xf2, 0xe8, 0x17, 0xab, 0x32, 0x22, 0x57, 0xf2, 0x55, 0x6e, 0xce, 0x42, 0x8c, 0xaa, 0xc2,
-	0xb7, 0xc0, 0x9c, 0x89, 0x94, 0x7b, 0x3c, 0xe5, 0xda, 0xdb, 0x75, 0x95, 0x13, 0x50, 0x38, 0x96,
-	0xcf, 0x3a, 0x0f, 0xa0, 0xa5, 0xb6, 0xb6, 0x4d, 0x68, 0x8c, 0x8e, 0x46, 0x43, 0xc5, 0xd0, 0xdd,
-	0xc3, 0xc3, 0xbe, 0x81, 0xa8, 0xfd, 0xdd, 0xc9, 0x6e, 0xbf, 0x86, 0xa3, 0xc9, 0xcf, 0x8f, 0x87,
-	0xfd, 0xba, 0xf3, 0xaf, 0x06, 0x98, 0xd9, 0x3e, 0xf6, 0x97, 0x00, 0x68, 0x25, 0xd3, 0x0b, 0x3f,
-	0xcc, 0x93, 0x82, 0x77, 0xca, 0x27, 0x6d, 0x1f, 0x27, 0xc2, 0xfb, 0x0a, 0x67, 0x55, 0x78, 0x20,
-	0xa3, 0x22, 0x78, 0x63, 0x0c, 0xeb, 0xd5, 0xc9, 0x15, 0xd9, 0xd1, 0xbd, 0xb2, 0x9f, 0x5c, 0xdf,
-	0xf9, 0x51, 0x65, 0x6b, 0x5c, 0x49, 0x8a, 0x5a, 0x72, 0x99, 0xf7, 0xc1, 0xcc, 0xd0, 0x76, 0x07,
-	0xda, 0xfb, 0xc3, 0xc7, 0xbb, 0x27, 0x87, 0xa8, 0x24, 0x00, 0xad, 0xf1, 0xc1, 0xe8, 0xc9, 0xe1,
-	0x50, 0x3d, 0xeb, 0xf0, 0x60, 0x3c, 0xe9, 0xd7, 0x9c, 0x3f, 0x37, 0xc0, 0xcc, 0x62, 0xb0, 0xfd,
-	0x09, 0x06, 0x4f, 0x0a, 0xf5, 0xda, 0xb7, 0x52, 0x31, 0x5d, 0x2a, 0x05, 0x58, 0x36, 0x8f, 0x4a,
-	0xef, 0x87, 0x9e, 0x58, 0x64, 0x51, 0x99, 0x80, 0x72, 0x21, 0x52, 0xaf, 0xd4, 0xc2, 0x58, 0x53,
-	0x45, 0xa1, 0xd0, 0x49, 0x16, 0x8d, 0x49, 0x07, 0xfd, 0xd0, 0x25, 0x9b, 0x6f, 0x6a, 0x1d, 0x44,
-	0x78, 0x22, 0x9d, 0x7f, 0xa9, 0x81, 0x99, 0x27, 0x5e, 0xf7, 0xc0, 0x9a, 0x65, 0x5a, 0xa0, 0x1d,
-	0x7a, 0xaf, 0xa2, 0x1a, 0xac, 0x98, 0xb7, 0x6f, 0x41, 0xed, 0xf2, 0x4a, 0x6b, 0x64, 0x0b, 0xa9,
-	0x9e, 0x3e, 0x67, 0xb5, 0xcb, 0xab, 0x22, 0x22, 0x34, 0x5f, 0x1b, 0x11, 0x3e, 0x86, 0x1b, 0x6e,
-	0x20, 0x78, 0x38, 0x2d, 0xfc, 0xa1, 0xb2, 0xec, 0x75, 0x42, 0x1f, 0xe7, 0x4e, 0x51, 0x4b, 0xab,
-	0x5d, 0x48, 0xeb, 0x43, 0x68, 0x7a, 0x22, 0x48, 0x79, 0xb9, 0x17, 0x71, 0x94, 0x70, 0x37, 0x10,
-	0xfb, 0x88, 0x66, 0x6a, 0x16, 0x95, 0x33, 0xcb, 0x0a, 0xcb, 0xca, 0x99, 0xc9, 0x81, 0xe5, 0xb3,
-	0x05, 0x9b, 0xa1, 0xcc, 0xe6, 0x7b, 0xf0, 0x86, 0x58, 0xc4, 0x64, 0x91, 0xd3, 0x3c, 0x83, 0xef,
-	0x10, 0x45, 0x3f, 0x9b, 0xd8, 0xd3, 0x78, 0xe7, 0x53, 0xa8, 0x3f, 0x7d, 0x3e, 0xd6, 0x8c, 0x31,
-	0x5e, 0x60, 0x4c, 0x26, 0x99, 0x5a, 0x21, 0x19, 0xe7, 0xff, 0xea, 0xd0, 0xd6, 0xbe, 0x10, 0x1f,
-	0x39, 0xcf, 0xeb, 0x32, 0x1c, 0x56, 0x13, 0xb2, 0xdc, 0xa9, 0x96, 0x9b, 0x5c, 0xf5, 0xd7, 0x37,
-	0xb9, 0xec, 0x2f, 0xa1, 0x1b, 0xab, 0xb9, 0xb2, 0x1b, 0x7e, 0xab, 0xbc, 0x46, 0xff, 0xd2, 0xba,
-	0x4e, 0x5c, 0x00, 0xa8, 0x39, 0xd4, 0x01, 0x48, 0xf9, 0x39, 0xc9, 0xb3, 0xcb, 0xda, 0x08, 0x4f,
-	0xf8, 0xf9, 0x4b, 0x9c, 0xf1, 0xaf, 0xe0, 0x53, 0xb1, 0xfe, 0x8c, 0xe2, 0x41, 0x97, 0xfc, 0x24,
-	0xfa, 0xe1, 0xb2, 0x8b, 0xec, 0x55, 0x5d, 0xe4, 0x3b, 0x60, 0xb9, 0xd1, 0x6c, 0xe6, 0xd3, 0xdc,
-	0xba, 0xae, 0x9c, 0x08, 0x31, 0x91, 0xce, 0x9f, 0x18, 0xd0, 0xd6, 0xaf, 0x7d, 0xc1, 0x00, 0x1f,
-	0x1d, 0x8c, 0x76, 0xd9, 0xcf, 0xfb, 0x06, 0x3a, 0x98, 0x83, 0xd1, 0xa4, 0x5f, 0xb3, 0x2d, 0x68,
-	0x3e, 0x3e, 0x3c, 0xda, 0x9d, 0xf4, 0xeb, 0x68, 0x94, 0x8f, 0x8e, 0x8e, 0x0e, 0xfb, 0x0d, 0xbb,
-	0x0b, 0xe6, 0xfe, 0xee, 0x64, 0x38, 0x39, 0x78, 0x36, 0xec, 0x37, 0x91, 0xf6, 0xc9, 0xf0, 0xa8,
-	0xdf, 0xc2, 0xc1, 0xc9, 0xc1, 0x7e, 0xbf, 0x8d, 0xf3, 0xc7, 0xbb, 0xe3, 0xf1, 0x37, 0x47, 0x6c,
-	0xbf, 0x6f, 0x92, 0x61, 0x4f, 0xd8, 0xc1, 0xe8, 0x49, 0xdf, 0xc2, 0xf1, 0xd1, 0xa3, 0xaf, 0x87,
-	0x7b, 0x93, 0x3e, 0x38, 0x9f, 0x42, 0xa7, 0xc4, 0x41, 0x5c, 0xcd, 0x86, 0x8f, 0xfb, 0x6b, 0x78,
-	0xe4, 0xf3, 0xdd, 0xc3, 0x13, 0xf4, 0x03, 0xeb, 0x00, 0x34, 0x9c, 0x1e, 0xee, 0x8e, 0x9e, 0xf4,
-	0x6b, 0xce, 0xcf, 0xc0, 0x3c, 0xf1, 0xbd, 0x47, 0x41, 0xe4, 0x5e, 0xa2, 0x62, 0x9c, 0x72, 0x29,
-	0x74, 0xd2, 0x46, 0x63, 0x8c, 0xbd, 0xa4, 0xc1, 0x52, 0xcb, 0x5e, 0x43, 0xc8, 0xab, 0x70, 0x3e,
-	0x9b, 0x52, 0x63, 0xb4, 0xae, 0x22, 0x4d, 0x38, 0x9f, 0x9d, 0xf8, 0x9e, 0x74, 0x46, 0xd0, 0x3e,
-	0xf1, 0xbd, 0x63, 0xee, 0x5e, 0xa2, 0xcb, 0x3e, 0xc5, 0xad, 0xa7, 0xd2, 0xff, 0x4e, 0xe8, 0x88,
-	0x64, 0x11, 0x66, 0xec, 0x7f, 0x27, 0xec, 0x0f, 0xa0, 0x45, 0x40, 0x96, 0xa0, 0x93, 0x4d, 0x64,
-	0xd7, 0x61, 0x7a, 0xce, 0xf9, 0x53, 0x23, 0x7f, 0x16, 0x75, 0xbe, 0xee, 0x40, 0x23, 0xe6, 0xee,
-	0xa5, 0x76, 0x58, 0x1d, 0xbd, 0x06, 0xcf, 0x63, 0x34, 0x61, 0x7f, 0x0c, 0xa6, 0xd6, 0x9d, 0x6c,
-	0xe3, 0x4e, 0x49, 0xc9, 0x58, 0x3e, 0x59, 0x95, 0x6a, 0xbd, 0x2a, 0x55, 0x4a, 0xe0, 0xe2, 0xc0,
-	0xa7, 0x26, 0x46, 0x1d, 0x1d, 0x9b, 0x82, 0x9c, 0xcf, 0x01, 0x8a, 0x66, 0xe3, 0x0a, 0xff, 0x7d,
-	0x13, 0x9a, 0x3c, 0xf0, 0x79, 0x96, 0x10, 0x2a, 0xc0, 0x19, 0x41, 0xa7, 0xd4, 0xa2, 0x44, 0xf6,
-	0xf1, 0x20, 0x98, 0x5e, 0x8a, 0x6b, 0x49, 0x6b, 0x4d, 0xd6, 0xe6, 0x41, 0xf0, 0x54, 0x5c, 0x4b,
-	0x8c, 0x9d, 0xaa, 0xbb, 0x59, 0x5b, 0x6a, 0x8c, 0xd1, 0x52, 0xa6, 0x26, 0x9d, 0x9f, 0x40, 0x4b,
-	0x75, 0xcb, 0x4a, 0x9a, 0x6e, 0xbc, 0x34, 0x7b, 0xf8, 0x42, 0xdf, 0x99, 0x7a, 0x6b, 0xf6, 0x3d,
-	0xdd, 0x45, 0x95, 0xaa, 0x67, 0x6b, 0x14, 0x25, 0x85, 0x22, 0xd2, 0x0d, 0x54, 0x22, 0x76, 0xf6,
-	0xc1, 0x7c, 0x65, 0x5f, 0x5a, 0x33, 0xa0, 0x56, 0x30, 0x60, 0x45, 0xa7, 0xda, 0xf9, 0x05, 0x40,
-	0xd1, 0x6d, 0xd5, 0x86, 0xa7, 0x76, 0x41, 0xc3, 0xbb, 0x0b, 0xa6, 0x7b, 0xe1, 0x07, 0x5e, 0x22,
-	0xc2, 0xca, 0xab, 0x8b, 0xfe, 0x6c, 0x3e, 0x6f, 0x6f, 0x42, 0x83, 0x9a, 0xc8, 0xf5, 0xc2, 0x8b,
-	0xe6, 0x1d, 0x64, 0x9a, 0x71, 0x16, 0xd0, 0x53, 0x49, 0x09, 0x13, 0xbf, 0x3f, 0x17, 0xf2, 0x95,
-	0x39, 0xf0, 0x6d, 0x15, 0xcc, 0xc9, 0xbb, 0x67, 0xed, 0xf0, 0x12, 0x06, 0x95, 0xe0, 0xcc, 0x17,
-	0x81, 0x97, 0xbd, 0x46, 0x43, 0x28, 0x64, 0x95, 0xe0, 0x34, 0x54, 0x77, 0x90, 0x00, 0xe7, 0x8f,
-	0x6a, 0x00, 0xea, 0xe8, 0x51, 0xe4, 0x89, 0x6a, 0x82, 0x6d, 0x2c, 0x27, 0xd8, 0x36, 0x34, 0xf2,
-	0xef, 0x03, 0x16, 0xa3, 0x71, 0xe1, 0xfc, 0x75, 0xd2, 0xad, 0x9c, 0xff, 0xbb, 0x60, 0xa5, 0xd1,
-	0xa5, 0x08, 0xfd, 0xef, 0xa8, 0xd7, 0x85, 0x07, 0x16, 0x88, 0x72, 0xb7, 0xbc, 0x59, 0xed, 0x96,
-	0xe7, 0x2d, 0xc5, 0x96, 0xda, 0x4d, 0xb5, 0x14, 0x57, 0x74, 0x47, 0x55, 0x91, 0x21, 0x45, 0x92,
-	0x66, 0x09, 0xbc, 0x82, 0xf2, 0x94, 0xd6, 0xd2, 0xb4, 0x98, 0xd2, 0xde, 0x81, 0x4e, 0x18, 0x4d,
-	0xdd, 0x28, 0x3c, 0x0b, 0x7c, 0x37, 0xd5, 0xdd, 0x71, 0x08, 0xa3, 0x3d, 0x8d, 0x71, 0xbe, 0x84,
-	0x6e, 0xc6, 0x7f, 0x6a, 0x42, 0xde, 0xcd, 0xd3, 0x46, 0xa3, 0x90, 0x6d, 0xc1, 0xa6, 0x47, 0xb5,
-	0x81, 0x91, 0x25, 0x8e, 0xce, 0xff, 0xd6, 0xb3, 0xc5, 0xba, 0x25, 0xf7, 0x6a, 0x1e, 0x56, 0xf3,
-	0xfa, 0xda, 0xaf, 0x94, 0xd7, 0xff, 0x14, 0x2c, 0x8f, 0x92, 0x5b, 0xff, 0x2a, 0x8b, 0x5b, 0x1b,
-	0xcb, 0x89, 0xac, 0x4e, 0x7f, 0xfd, 0x2b, 0xc1, 0x0a, 0xe2, 0xd7, 0xc8, 0x21, 0xe7, 0x76, 0x73,
-	0x15, 0xb7, 0x5b, 0xbf, 0x26, 0xb7, 0xdf, 0x83, 0x6e, 0x18, 0x85, 0xd3, 0x70, 0x1e, 0x04, 0x58,
-	0xd0, 0x69, 0x76, 0x77, 0xc2, 0x28, 0x1c, 0x69, 0x94, 0x7d, 0x17, 0xde, 0x28, 0x93, 0x28, 0xa3,
-	0xee, 0xa8, 0xae, 0x65, 0x89, 0x8e, 0x4c, 0x7f, 0x0b, 0xfa, 0xd1, 0xe9, 0x2f, 0x84, 0x9b, 0x12,
-	0xc7, 0xa6, 0x64, 0xcd, 0x5d, 0x95, 0xea, 0x28, 0x3c, 0xb2, 0x68, 0x84, 0x76, 0xbd, 0x24, 0xe6,
-	0xde, 0x0b, 0x62, 0xfe, 0x02, 0xac, 0x9c, 0x4b, 0xa5, 0x44, 0xda, 0x82, 0xe6, 0xc1, 0x68, 0x7f,
-	0xf8, 0xbb, 0x7d, 0x03, 0x63, 0x21, 0x1b, 0x3e, 0x1f, 0xb2, 0xf1, 0xb0, 0x5f, 0xc3, 0x38, 0xb5,
-	0x3f, 0x3c, 0x1c, 0x4e, 0x86, 0xfd, 0xfa, 0xd7, 0x0d, 0xb3, 0xdd, 0x37, 0xa9, 0x27, 0x17, 0xf8,
-	0xae, 0x9f, 0x3a, 0x63, 0x80, 0xa2, 0x3a, 0x40, 0xaf, 0x5c, 0x5c, 0x4e, 0x17, 0xdc, 0x69, 0x76,
-	0xad, 0xad, 0xdc, 0x20, 0x6b, 0x2f, 0xab, 0x41, 0xd4, 0xbc, 0x73, 0x02, 0xe6, 0x33, 0x1e, 0xbf,
-	0x90, 0x65, 0x77, 0xf3, 0x0e, 0xd6, 0x5c, 0xb7, 0x99, 0x75, 0x92, 0xf3, 0x21, 0xb4, 0x75, 0x60,
-	0xd0, 0xbe, 0xa5, 0x12, 0x34, 0xb2, 0x39, 0xe7, 0x6f, 0x0d, 0xb8, 0xf9, 0x2c, 0xba, 0x12, 0x79,
-	0x52, 0x78, 0xcc, 0xaf, 0x83, 0x88, 0x7b, 0xaf, 0xd1, 0xd4, 0x1f, 0x03, 0xc8, 0x68, 0x4e, 0xfd,
-	0xe2, 0xbc, 0xbb, 0x6d, 0x29, 0xcc, 0x13, 0xfd, 0x79, 0x4d, 0xc8, 0x94, 0x26, 0x75, 0x38, 0x45,
-	0x18, 0xa7, 0x7e, 0x04, 0xad, 0x74, 0x11, 0x16, 0xcd, 0xf4, 0x66, 0x4a, 0x1d, 0xa3, 0x95, 0x19,
-	0x61, 0xf3, 0x25, 0x19, 0xe1, 0x1e, 0x58, 0x93, 0x05, 0x75, 0x53, 0xe6, 0xb2, 0x92, 0xe6, 0x18,
-	0xaf, 0x48, 0x73, 0x6a, 0x4b, 0x69, 0xce, 0x7f, 0x1b, 0xd0, 0x29, 0xa5, 0xb6, 0xf6, 0x7b, 0xd0,
-	0x48, 0x17, 0x61, 0xf5, 0x93, 0x55, 0x76, 0x08, 0xa3, 0x29, 0xd4, 0xde, 0x19, 0x5f, 0x4c, 0xb9,
-	0x94, 0xfe, 0x79, 0x28, 0x3c, 0xbd, 0x65, 0x67, 0xc6, 0x17, 0xbb, 0x1a, 0x65, 0x1f, 0xc2, 0x0d,
-	0xe5, 0x9c, 0xb3, 0x47, 0x64, 0x65, 0xe8, 0xfb, 0x4b, 0xa9, 0xb4, 0xea, 0x38, 0x65, 0x4f, 0xd2,
-	0xb5, 0xd5, 0xfa, 0x79, 0x05, 0xb9, 0xb1, 0x0b, 0x6f, 0xae, 0x20, 0xfb, 0x41, 0x3d, 0xc6, 0x3b,
-	0xd0, 0x9b, 0x2c, 0xc2, 0x89, 0x3f, 0x13, 0x32, 0xe5, 0xb3, 0x98, 0xd2, 0x44, 0x1d, 0x5c, 0x1b,
-	0xac, 0x96, 0x4a, 0xe7, 0x23, 0xe8, 0x1e, 0x0b, 0x91, 0x30, 0x21, 0xe3, 0x28, 0x54, 0x29, 0x92,
-	0xee, 0xf4, 0xa8, 0x48, 0xae, 0x21, 0xe7, 0xf7, 0xc0, 0xc2, 0x42, 0xea, 0x11, 0x4f, 0xdd, 0x8b,
-	0x1f, 0x52, 0x68, 0x7d, 0x04, 0xed, 0x58, 0xe9, 0x94, 0xae, 0x7d, 0xba, 0x14, 0xd1, 0xb5, 0x9e,
-	0xb1, 0x6c, 0xd2, 0xf9, 0x14, 0xde, 0x1c, 0xcf, 0x4f, 0xa5, 0x9b, 0xf8, 0x31, 0x45, 0x3f, 0x1d,
-	0xed, 0x36, 0xc0, 0x8c, 0x13, 0x71, 0xe6, 0x2f, 0xf4, 0xd7, 0xc4, 0x2e, 0xcb, 0x61, 0xe7, 0x73,
-	0xb8, 0x59, 0x5d, 0xa2, 0x9f, 0xf0, 0x2e, 0xd4, 0x2f, 0xaf, 0x64, 0xb9, 0xbd, 0xf6, 0xf4, 0x39,
-	0x7d, 0x22, 0x42, 0xb4, 0xc3, 0xa0, 0x3e, 0x9a, 0xcf, 0xca, 0x9f, 0xb9, 0x1b, 0xea, 0x33, 0x77,
-	0xa5, 0xbf, 0x53, 0x5b, 0xea, 0xef, 0xbc, 0x0b, 0xd6, 0x59, 0x94, 0xfc, 0x01, 0x4f, 0x3c, 0xe1,
-	0xe9, 0x78, 0x56, 0x20, 0x9c, 0x6f, 0xa1, 0x93, 0xa9, 0xc0, 0x81, 0x47, 0x5d, 0x79, 0xd2, 0xc1,
-	0x03, 0xaf, 0xa2, 0x92, 0xaa, 0xd7, 0x22, 0x42, 0xef, 0x20, 0xd3, 0x1d, 0x05, 0x54, 0x4f, 0xd6,
-	0xcd, 0xd4, 0xec, 0x64, 0xe7, 0x31, 0x74, 0xb3, 0xc2, 0x0a, 0x0b, 0x67, 0xd2, 0xea, 0xc0, 0x17,
-	0x61, 0x49, 0xe3, 0x4d, 0x85, 0x98, 0xc8, 0x57, 0x7c, 0x74, 0x72, 0xb6, 0xa1, 0xa5, 0x4d, 0xc6,
-	0x86, 0x86, 0x1b, 0x79, 0xca, 0xac, 0x9b, 0x8c, 0xc6, 0xc8, 0x8e, 0x99, 0x3c, 0xcf, 0x12, 0x9f,
-	0x99, 0x3c, 0x77, 0xfe, 0xbe, 0x06, 0xbd, 0x47, 0xdc, 0xbd, 0x9c, 0xc7, 0x99, 0x2c, 0x4a, 0xd5,
-	0xb1, 0x51, 0xa9, 0x8e, 0xcb, 0x95, 0x70, 0xad, 0x52, 0x09, 0x57, 0x2e, 0x54, 0xaf, 0x66, 0x2b,
-	0x6f, 0x41, 0x7b, 0x1e, 0xfa, 0x8b, 0xcc, 0x17, 0x58, 0xac, 0x85, 0xe0, 0x44, 0xda, 0x9b, 0xd0,
-	0x41, 0x77, 0xe1, 0x87, 0x54, 0x13, 0xeb, 0x16, 0x62, 0x19, 0x85, 0xfe, 0x87, 0xbb, 0xae, 0x90,
-	0x12, 0x73, 0x4e, 0x5d, 0x2a, 0x59, 0x0a, 0xf3, 0x54, 0x5c, 0x93, 0x7b, 0x12, 0x6e, 0x22, 0xd2,
-	0x69, 0x51, 0xdf, 0x5a, 0x0a, 0x83, 0xd3, 0xef, 0x43, 0x4f, 0x0a, 0x29, 0xfd, 0x28, 0x9c, 0x52,
-	0xc0, 0xd3, 0xdd, 0x97, 0xae, 0x46, 0x4e, 0x10, 0x87, 0x02, 0xe7, 0x61, 0x14, 0x5e, 0xcf, 0xa2,
-	0xb9, 0xd4, 0x31, 0xac, 0x40, 0x2c, 0x65, 0x5a, 0xb0, 0x9c, 0x69, 0x39, 0x29, 0xf4, 0x86, 0x8b,
-	0x98, 0x3e, 0x5d, 0xbe, 0x36, 0x6b, 0x2b, 0xb1, 0xb5, 0x56, 0x61, 0x6b, 0x89, 0x41, 0x75, 0xdd,
-	0x2d, 0x55, 0x0c, 0xc2, 0x3c, 0x2e, 0x4a, 0x66, 0x3c, 0xcd, 0x18, 0xa7, 0x20, 0xe7, 0xcf, 0x6a,
-	0x60, 0x29, 0x91, 0xe1, 0x33, 0x3f, 0xd1, 0x29, 0x99, 0x51, 0x74, 0x5e, 0xf2, 0xc9, 0xed, 0xa7,
-	0xe2, 0x9a, 0x52, 0x09, 0x95, 0xa9, 0xad, 0xea, 0x3d, 0xea, 0x98, 0xa2, 0x0a, 0x09, 0x8a, 0x29,
-	0xef, 0x80, 0xa5, 0x5c, 0x2d, 0xe2, 0xf5, 0x07, 0x37, 0x42, 0x9c, 0xf8, 0xf4, 0x4d, 0x33, 0x15,
-	0xc9, 0x4c, 0x4b, 0x8b, 0xc6, 0xd5, 0x94, 0xad, 0xa7, 0x93, 0x08, 0xe7, 0x02, 0xda, 0xfa, 0x74,
-	0x8c, 0xa9, 0x27, 0xa3, 0xa7, 0xa3, 0xa3, 0x6f, 0x46, 0xfd, 0xb5, 0xbc, 0x57, 0x65, 0x14, 0x51,
-	0xb7, 0x56, 0x8e, 0xba, 0x75, 0xc4, 0xef, 0x1d, 0x9d, 0x8c, 0x26, 0xfd, 0x86, 0xdd, 0x03, 0x8b,
-	0x86, 0x53, 0x36, 0x7c, 0xde, 0x6f, 0x52, 0x0d, 0xb9, 0xf7, 0xd5, 0xf0, 0xd9, 0x6e, 0xbf, 0x95,
-	0x77, 0xba, 0xda, 0xce, 0x1f, 0x1b, 0xf0, 0x86, 0x7a, 0x72, 0xb9, 0xe2, 0x2a, 0xff, 0x03, 0xa6,
-	0xa1, 0xfe, 0x01, 0xf3, 0x9b, 0x2d, 0xb2, 0x76, 0xfe, 0xc9, 0x80, 0x06, 0x3a, 0x47, 0xfb, 0x3e,
-	0x58, 0x5f, 0x09, 0x9e, 0xa4, 0xa7, 0x82, 0xa7, 0x76, 0xc5, 0x11, 0x6e, 0x50, 0x1e, 0x59, 0xf4,
-	0xe9, 0x9d, 0xb5, 0x87, 0x86, 0xbd, 0xad, 0xbe, 0x63, 0x67, 0x9f, 0xe7, 0x7b, 0x99, 0x93, 0x25,
-	0x27, 0xbc, 0x51, 0x59, 0xef, 0xac, 0x6d, 0x11, 0xfd, 0xd7, 0x91, 0x1f, 0xee, 0xa9, 0x8f, 0xbb,
-	0xf6, 0xb2, 0x53, 0x5e, 0x5e, 0x61, 0xdf, 0x87, 0xd6, 0x81, 0x44, 0xef, 0xff, 0x22, 0x29, 0x65,
-	0x22, 0xe5, 0xc0, 0xe0, 0xac, 0xed, 0xfc, 0x4d, 0x1d, 0x1a, 0xdf, 0x8a, 0x24, 0xb2, 0x7f, 0x02,
-	0x6d, 0xfd, 0x55, 0xc3, 0x2e, 0x7d, 0xbd, 0xd8, 0xa0, 0x5c, 0x75, 0xe9, 0x73, 0x07, 0x9d, 0xd2,
-	0x57, 0xc9, 0x4c, 0xd1, 0xaf, 0xb2, 0x8b, 0x8f, 0x2e, 0x2f, 0x5c, 0xea, 0x0b, 0xe8, 0x8f, 0xd3,
-	0x44, 0xf0, 0x59, 0x89, 0xbc, 0xca, 0xaa, 0x55, 0xcd, 0x2f, 0xe2, 0xd7, 0x3d, 0x68, 0xa9, 0x10,
-	0xbb, 0xb4, 0x60, 0xb9, 0x8f, 0x45, 0xc4, 0x1f, 0x43, 0x67, 0x7c, 0x11, 0xcd, 0x03, 0x6f, 0x2c,
-	0x92, 0x2b, 0x61, 0x97, 0xbe, 0x53, 0x6e, 0x94, 0xc6, 0xce, 0x9a, 0xbd, 0x05, 0xa0, 0x9c, 0xfb,
-	0x09, 0x6a, 0x4a, 0x1b, 0xe7, 0x46, 0xf3, 0x99, 0xda, 0xb4, 0xe4, 0xf5, 0x15, 0x65, 0x29, 0xd2,
-	0xbe, 0x8a, 0xf2, 0x33, 0xe8, 0xed, 0x91, 0xd6, 0x1c, 0x25, 0xbb, 0xa7, 0x51, 0x92, 0xda, 0xcb,
-	0xdf, 0x2a, 0x37, 0x96, 0x11, 0xce, 0x9a, 0xfd, 0x10, 0xcc, 0x49, 0x72, 0xad, 0xe8, 0xdf, 0xd0,
-	0x09, 0x4a, 0x71, 0xde, 0x8a, 0x57, 0xee, 0xfc, 0x7b, 0x1d, 0x5a, 0xdf, 0x44, 0xc9, 0xa5, 0x48,
-	0xb0, 0x6e, 0xa1, 0x86, 0xa3, 0x56, 0xa3, 0xbc, 0xf9, 0xb8, 0xea, 0xa0, 0x0f, 0xc0, 0x22, 0xa6,
-	0x4c, 0xb8, 0xbc, 0x54, 0xa2, 0xa2, 0x7f, 0x5f, 0x29, 0xbe, 0xa8, 0x3a, 0x88, 0xe4, 0xba, 0xae,
-	0x04, 0x95, 0xf7, 0x5f, 0x2b, 0x5d, 0xc0, 0x8d, 0xb6, 0x8a, 0xbc, 0x63, 0x54, 0xcd, 0x87, 0x06,
-	0xba, 0xa3, 0xb1, 0x7a, 0x29, 0x12, 0x15, 0xff, 0x3a, 0x51, 0x9a, 0x5f, 0xfc, 0xcd, 0xc3, 0x59,
-	0xb3, 0x1f, 0x40, 0x4b, 0x25, 0xc1, 0xea, 0x99, 0x95, 0xfa, 0x77, 0xa3, 0x5f, 0x46, 0xe9, 0x05,
-	0x9f, 0x40, 0x4b, 0xd9, 0xb9, 0x5a, 0x50, 0x09, 0x5b, 0xea, 0xd6, 0x2a, 0xf4, 0x29, 0x52, 0xe5,
-	0x99, 0x15, 0x69, 0xc5, 0x4b, 0x2f, 0x91, 0xde, 0x87, 0x3e, 0x13, 0xae, 0xf0, 0x4b, 0xe9, 0xb1,
-	0x9d, 0x3d, 0x6a, 0x85, 0xf5, 0x7d, 0x01, 0xbd, 0x4a, 0x2a, 0x6d, 0x0f, 0x88, 0xd1, 0x2b, 0xb2,
-	0xeb, 0x17, 0x74, 0xfe, 0x73, 0xb0, 0x74, 0x26, 0x73, 0x2a, 0x6c, 0xea, 0x21, 0xae, 0xc8, 0x85,
-	0x36, 0x4a, 0xa9, 0x0c, 0x6a, 0xf0, 0xa3, 0xfe, 0x3f, 0x7f, 0x7f, 0xdb, 0xf8, 0xb7, 0xef, 0x6f,
-	0x1b, 0xff, 0xf9, 0xfd, 0x6d, 0xe3, 0x97, 0xff, 0x75, 0x7b, 0xed, 0xb4, 0x45, 0xff, 0xf5, 0xfb,
-	0xec, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0xbd, 0xc1, 0xfa, 0x1e, 0x2f, 0x28, 0x00, 0x00,
+	// 4112 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x3a, 0x4d, 0x6f, 0x1c, 0x47,
+	0x76, 0xea, 0xee, 0xf9, 0xe8, 0x7e, 0x33, 0x43, 0x8d, 0xdb, 0x5a, 0x7b, 0x4c, 0x7b, 0x25, 0xba,
+	0x6d, 0x59, 0xb4, 0xb5, 0xa2, 0x64, 0xda, 0x8b, 0xac, 0x0d, 0xe4, 0x40, 0x91, 0x23, 0x99, 0x16,
+	0x39, 0xe4, 0xd6, 0x0c, 0xe5, 0xec, 0x1e, 0x32, 0x68, 0x76, 0x17, 0xc9, 0x5e, 0xf6, 0x74, 0x77,
+	0xba, 0x7a, 0x98, 0xa1, 0x6f, 0x41, 0x90, 0x9c, 0x92, 0x4b, 0x02, 0x04, 0x7b, 0x4a, 0x72, 0xce,
+	0x25, 0x40, 0x4e, 0x41, 0x02, 0xe4, 0x94, 0x43, 0x92, 0x53, 0xfe, 0x41, 0x02, 0x27, 0xb7, 0x1c,
+	0x03, 0xe4, 0x1c, 0xbc, 0x57, 0xd5, 0x5f, 0xa3, 0x91, 0xb4, 0x5e, 0x60, 0x4f, 0x53, 0xef, 0xa3,
+	0xbe, 0xde, 0x7b, 0xf5, 0xbe, 0x7a, 0xc0, 0x4c, 0x4e, 0xb7, 0x92, 0x34, 0xce, 0x62, 0x5b, 0x4f,
+	0x4e, 0xd7, 0x2d, 0x37, 0x09, 0x24, 0xb8, 0x7e, 0xef, 0x3c, 0xc8, 0x2e, 0xe6, 0xa7, 0x5b, 0x5e,
+	0x3c, 0x7b, 0xe8, 0x9f, 0xa7, 0x6e, 0x72, 0xf1, 0x20, 0x88, 0x1f, 0x9e, 0xba, 0xfe, 0x39, 0x4f,
+	0x1f, 0x26, 0xa7, 0x0f, 0xf3, 0x79, 0xce, 0x3a, 0x34, 0x0e, 0x02, 0x91, 0xd9, 0x36, 0x34, 0xe6,
+	0x81, 0x2f, 0x06, 0xda, 0x86, 0xb1, 0xd9, 0x62, 0x34, 0x76, 0x0e, 0xc1, 0x9a, 0xb8, 0xe2, 0xf2,
+	0xb9, 0x1b, 0xce, 0xb9, 0xdd, 0x07, 0xe3, 0xca, 0x0d, 0x07, 0xda, 0x86, 0xb6, 0xd9, 0x65, 0x38,
+	0xb4, 0xb7, 0xc0, 0xbc, 0x72, 0xc3, 0x69, 0x76, 0x9d, 0xf0, 0x81, 0xbe, 0xa1, 0x6d, 0xae, 0x6d,
+	0xbf, 0xb9, 0x95, 0x9c, 0x6e, 0x1d, 0xc7, 0x22, 0x0b, 0xa2, 0xf3, 0xad, 0xe7, 0x6e, 0x38, 0xb9,
+	0x4e, 0x38, 0x6b, 0x5f, 0xc9, 0x81, 0x73, 0x04, 0x9d, 0x71, 0xea, 0x3d, 0x99, 0x47, 0x5e, 0x16,
+	0xc4, 0x11, 0xee, 0x18, 0xb9, 0x33, 0x4e, 0x2b, 0x5a, 0x8c, 0xc6, 0x88, 0x73, 0xd3, 0x73, 0x31,
+	0x30, 0x36, 0x0c, 0xc4, 0xe1, 0xd8, 0x1e, 0x40, 0x3b, 0x10, 0xbb, 0xf1, 0x3c, 0xca, 0x06, 0x8d,
+	0x0d, 0x6d, 0xd3, 0x64, 0x39, 0xe8, 0xfc, 0x95, 0x01, 0xcd, 0x9f, 0xce, 0x79, 0x7a, 0x4d, 0xf3,
+	0xb2, 0x2c, 0xcd, 0xd7, 0xc2, 0xb1, 0x7d, 0x0b, 0x9a, 0xa1, 0x1b, 0x9d, 0x8b, 0x81, 0x4e, 0x8b,
+	0x49, 0xc0, 0x7e, 0x17, 0x2c, 0xf7, 0x2c, 0xe3, 0xe9, 0x74, 0x1e, 0xf8, 0x03, 0x63, 0x43, 0xdb,
+	0x6c, 0x31, 0x93, 0x10, 0x27, 0x81, 0x6f, 0xbf, 0x03, 0xa6, 0x1f, 0x4f, 0xbd, 0xea, 0x5e, 0x7e,
+	0x4c, 0x7b, 0xd9, 0x1f, 0x80, 0x39, 0x0f, 0xfc, 0x69, 0x18, 0x88, 0x6c, 0xd0, 0xdc, 0xd0, 0x36,
+	0x3b, 0xdb, 0x26, 0x5e, 0x16, 0x65, 0xc7, 0xda, 0xf3, 0xc0, 0xc7, 0x81, 0xfd, 0x09, 0x98, 0x22,
+	0xf5, 0xa6, 0x67, 0xf3, 0xc8, 0x1b, 0xb4, 0x88, 0xe9, 0x26, 0x32, 0x55, 0x6e, 0xcd, 0xda, 0x42,
+	0x02, 0x78, 0xad, 0x94, 0x5f, 0xf1, 0x54, 0xf0, 0x41, 0x5b, 0x6e, 0xa5, 0x40, 0xfb, 0x11, 0x74,
+	0xce, 0x5c, 0x8f, 0x67, 0xd3, 0xc4, 0x4d, 0xdd, 0xd9, 0xc0, 0x2c, 0x17, 0x7a, 0x82, 0xe8, 0x63,
+	0xc4, 0x0a, 0x06, 0x67, 0x05, 0x60, 0x7f, 0x06, 0x3d, 0x82, 0xc4, 0xf4, 0x2c, 0x08, 0x33, 0x9e,
+	0x0e, 0x2c, 0x9a, 0xb3, 0x46, 0x73, 0x08, 0x33, 0x49, 0x39, 0x67, 0x5d, 0xc9, 0x24, 0x31, 0xf6,
+	0x0f, 0x01, 0xf8, 0x22, 0x71, 0x23, 0x7f, 0xea, 0x86, 0xe1, 0x00, 0xe8, 0x0c, 0x96, 0xc4, 0xec,
+	0x84, 0xa1, 0xfd, 0x36, 0x9e, 0xcf, 0xf5, 0xa7, 0x99, 0x18, 0xf4, 0x36, 0xb4, 0xcd, 0x06, 0x6b,
+	0x21, 0x38, 0x11, 0x28, 0x57, 0xcf, 0xf5, 0x2e, 0xf8, 0x60, 0x6d, 0x43, 0xdb, 0x6c, 0x32, 0x09,
+	0x20, 0xf6, 0x2c, 0x48, 0x45, 0x36, 0xb8, 0x29, 0xb1, 0x04, 0x38, 0xdb, 0x60, 0x91, 0xf5, 0x90,
+	0x74, 0xee, 0x42, 0xeb, 0x0a, 0x01, 0x69, 0x64, 0x9d, 0xed, 0x1e, 0x1e, 0xaf, 0x30, 0x30, 0xa6,
+	0x88, 0xce, 0x6d, 0x30, 0x0f, 0xdc, 0xe8, 0x3c, 0xb7, 0x4a, 0x54, 0x1b, 0x4d, 0xb0, 0x18, 0x8d,
+	0x9d, 0x5f, 0xea, 0xd0, 0x62, 0x5c, 0xcc, 0xc3, 0xcc, 0xbe, 0x07, 0x80, 0x4a, 0x99, 0xb9, 0x59,
+	0x1a, 0x2c, 0xd4, 0xaa, 0xa5, 0x5a, 0xac, 0x79, 0xe0, 0x1f, 0x12, 0xc9, 0x7e, 0x04, 0x5d, 0x5a,
+	0x3d, 0x67, 0xd5, 0xcb, 0x03, 0x14, 0xe7, 0x63, 0x1d, 0x62, 0x51, 0x33, 0xde, 0x82, 0x16, 0xd9,
+	0x81, 0xb4, 0xc5, 0x1e, 0x53, 0x90, 0x7d, 0x17, 0xd6, 0x82, 0x28, 0x43, 0x3d, 0x79, 0xd9, 0xd4,
+	0xe7, 0x22, 0x37, 0x94, 0x5e, 0x81, 0xdd, 0xe3, 0x22, 0xb3, 0x3f, 0x05, 0x29, 0xec, 0x7c, 0xc3,
+	0xe6, 0x86, 0x51, 0x28, 0x84, 0x94, 0x20, 0x77, 0x24, 0x1e, 0xb5, 0xe3, 0x03, 0xe8, 0xe0, 0xfd,
+	0xf2, 0x19, 0x2d, 0x9a, 0xd1, 0xa5, 0xdb, 0x28, 0x71, 0x30, 0x40, 0x06, 0xc5, 0x8e, 0xa2, 0x41,
+	0x63, 0x94, 0xc6, 0x43, 0x63, 0x67, 0x08, 0xcd, 0xa3, 0xd4, 0xe7, 0xe9, 0xca, 0xf7, 0x60, 0x43,
+	0xc3, 0xe7, 0xc2, 0xa3, 0xa7, 0x6a, 0x32, 0x1a, 0x97, 0x6f, 0xc4, 0xa8, 0xbc, 0x11, 0xe7, 0x2f,
+	0x35, 0xe8, 0x8c, 0xe3, 0x34, 0x3b, 0xe4, 0x42, 0xb8, 0xe7, 0xdc, 0xbe, 0x03, 0xcd, 0x18, 0x97,
+	0x55, 0x12, 0xb6, 0xf0, 0x4c, 0xb4, 0x0f, 0x93, 0xf8, 0x25, 0x3d, 0xe8, 0x2f, 0xd7, 0x03, 0xda,
+	0x0e, 0xbd, 0x2e, 0x43, 0xd9, 0x0e, 0x02, 0x28, 0xeb, 0xf8, 0xec, 0x4c, 0x70, 0x29, 0xcb, 0x26,
+	0x53, 0xd0, 0x4b, 0x4d, 0xd0, 0xf9, 0x31, 0x00, 0x9e, 0xef, 0x7b, 0x5a, 0x81, 0x73, 0x01, 0x1d,
+	0xe6, 0x9e, 0x65, 0xbb, 0x71, 0x94, 0xf1, 0x45, 0x66, 0xaf, 0x81, 0x1e, 0xf8, 0x24, 0xa2, 0x16,
+	0xd3, 0x03, 0x1f, 0x0f, 0x77, 0x9e, 0xc6, 0xf3, 0x84, 0x24, 0xd4, 0x63, 0x12, 0x20, 0x51, 0xfa,
+	0x7e, 0x3a, 0x30, 0x94, 0x28, 0x7d, 0x3f, 0xb5, 0xef, 0x40, 0x47, 0x44, 0x6e, 0x22, 0x2e, 0xe2,
+	0x0c, 0x0f, 0xd7, 0xa0, 0xc3, 0x41, 0x8e, 0x9a, 0x08, 0xe7, 0x7f, 0x34, 0x68, 0x1d, 0xf2, 0xd9,
+	0x29, 0x4f, 0x5f, 0xd8, 0xe5, 0x1d, 0x30, 0x69, 0xe1, 0x69, 0xe0, 0xab, 0x8d, 0xda, 0x04, 0xef,
+	0xfb, 0x2b, 0xb7, 0x7a, 0x0b, 0x5a, 0x21, 0x77, 0x51, 0xf8, 0xd2, 0xce, 0x14, 0x84, 0xb2, 0x71,
+	0x67, 0x53, 0x9f, 0xbb, 0x3e, 0xb9, 0x23, 0x93, 0xb5, 0xdc, 0xd9, 0x1e, 0x77, 0x7d, 0x3c, 0x5b,
+	0xe8, 0x8a, 0x6c, 0x3a, 0x4f, 0x7c, 0x37, 0xe3, 0xe4, 0x86, 0x1a, 0x68, 0x38, 0x22, 0x3b, 0x21,
+	0x8c, 0xfd, 0x09, 0xbc, 0xe1, 0x85, 0x73, 0x81, 0x3e, 0x30, 0x88, 0xce, 0xe2, 0x69, 0x1c, 0x85,
+	0xd7, 0x24, 0x5f, 0x93, 0xdd, 0x54, 0x84, 0xfd, 0xe8, 0x2c, 0x3e, 0x8a, 0xc2, 0x6b, 0xfb, 0x43,
+	0x58, 0x3b, 0x8b, 0x53, 0x8f, 0x4f, 0x8b, 0x23, 0xaf, 0x11, 0x63, 0x97, 0xb0, 0x4f, 0xe5, 0xb9,
+	0x9d, 0xbf, 0xd7, 0xa1, 0x49, 0x63, 0xfb, 0x11, 0xb4, 0x67, 0x74, 0xed, 0xfc, 0x8d, 0xbf, 0x85,
+	0x7a, 0x20, 0xda, 0x96, 0x94, 0x87, 0x18, 0x46, 0x59, 0x7a, 0xcd, 0x72, 0x36, 0x9c, 0x91, 0xb9,
+	0xa7, 0x21, 0xcf, 0xc4, 0x40, 0x5f, 0x9e, 0x31, 0x91, 0x04, 0x35, 0x43, 0xb1, 0x2d, 0x0b, 0xdf,
+	0x58, 0x16, 0xbe, 0xbd, 0x0e, 0xa6, 0x77, 0xc1, 0xbd, 0x4b, 0x31, 0x9f, 0x29, 0xd5, 0x14, 0xf0,
+	0xfa, 0x13, 0xe8, 0x56, 0xcf, 0x81, 0x51, 0xed, 0x92, 0x5f, 0x93, 0x7a, 0x1a, 0x0c, 0x87, 0xf6,
+	0x06, 0x34, 0xc9, 0x0f, 0x90, 0x72, 0x3a, 0xdb, 0x80, 0xc7, 0x91, 0x53, 0x98, 0x24, 0x7c, 0xa9,
+	0xff, 0x44, 0xc3, 0x75, 0xaa, 0xa7, 0xab, 0xae, 0x63, 0xbd, 0x7c, 0x1d, 0x39, 0xa5, 0xb2, 0x8e,
+	0x13, 0x43, 0xfb, 0x20, 0xf0, 0x78, 0x24, 0x28, 0xf6, 0xcd, 0x05, 0x2f, 0xde, 0x2c, 0x8e, 0xf1,
+	0x2a, 0x33, 0x77, 0x31, 0x8a, 0x7d, 0x2e, 0x68, 0x9d, 0x06, 0x2b, 0x60, 0xa4, 0xf1, 0x45, 0x12,
+	0xa4, 0xd7, 0x13, 0x29, 0x04, 0x83, 0x15, 0x30, 0x06, 0x17, 0x1e, 0xe1, 0x66, 0x7e, 0x1e, 0xc7,
+	0x14, 0xe8, 0xfc, 0xb5, 0x01, 0xdd, 0x9f, 0xf3, 0x34, 0x3e, 0x4e, 0xe3, 0x24, 0x16, 0x6e, 0x68,
+	0xef, 0xd4, 0xc5, 0x29, 0xd5, 0xb6, 0x81, 0xa7, 0xad, 0xb2, 0x6d, 0x8d, 0x0b, 0xf9, 0x4a, 0x75,
+	0x54, 0x05, 0xee, 0x40, 0x4b, 0xaa, 0x73, 0x85, 0xcc, 0x14, 0x05, 0x79, 0xa4, 0x02, 0x07, 0x46,
+	0xc9, 0xa3, 0xe4, 0xa1, 0x28, 0xf6, 0x6d, 0x80, 0x99, 0xbb, 0x38, 0xe0, 0xae, 0xe0, 0xfb, 0x7e,
+	0xfe, 0xaa, 0x4a, 0x8c, 0x92, 0xc6, 0x64, 0x11, 0x4d, 0xc4, 0xa0, 0x59, 0x48, 0x83, 0x60, 0xfb,
+	0x3d, 0xb0, 0x66, 0xee, 0x02, 0x9f, 0xf7, 0xbe, 0xaf, 0x8c, 0xbe, 0x44, 0xd8, 0xef, 0x83, 0x91,
+	0x2d, 0xa2, 0x41, 0x5b, 0x85, 0x52, 0xcc, 0x93, 0x26, 0x8b, 0x48, 0x39, 0x02, 0x86, 0xb4, 0x5c,
+	0x83, 0x66, 0xa9, 0xc1, 0x3e, 0x18, 0x5e, 0xe0, 0x53, 0x2c, 0xb5, 0x18, 0x0e, 0xed, 0xbb, 0xd0,
+	0x0e, 0xa5, 0xb6, 0x28, 0x5e, 0x76, 0xb6, 0x3b, 0xd2, 0xcd, 0x10, 0x8a, 0xe5, 0xb4, 0xf5, 0xdf,
+	0x86, 0x9b, 0x4b, 0xe2, 0xaa, 0xda, 0x47, 0x4f, 0xae, 0x7e, 0xab, 0x6a, 0x1f, 0x8d, 0xaa, 0x4d,
+	0xfc, 0x87, 0x01, 0x37, 0x95, 0x91, 0x5e, 0x04, 0xc9, 0x38, 0xc3, 0x47, 0x3b, 0x80, 0x36, 0xf9,
+	0x4a, 0x65, 0x1f, 0x0d, 0x96, 0x83, 0xf6, 0x6f, 0x41, 0x8b, 0x1e, 0x67, 0xfe, 0x7e, 0xee, 0x94,
+	0xc2, 0x2f, 0xa6, 0xcb, 0xf7, 0xa4, 0x34, 0xa7, 0xd8, 0xed, 0xcf, 0xa1, 0xf9, 0x2d, 0x4f, 0x63,
+	0xe9, 0xfb, 0x3b, 0xdb, 0xb7, 0x57, 0xcd, 0x43, 0x13, 0x50, 0xd3, 0x24, 0xf3, 0x6f, 0x50, 0x47,
+	0x1f, 0xa2, 0xb7, 0x9f, 0xc5, 0x57, 0xdc, 0x1f, 0xb4, 0x37, 0x8c, 0xdc, 0x44, 0x94, 0x19, 0xe5,
+	0xa4, 0x5c, 0x29, 0xe6, 0x4a, 0xa5, 0x58, 0xaf, 0x50, 0xca, 0x1e, 0x74, 0x2a, 0x52, 0x58, 0xa1,
+	0x90, 0x3b, 0xf5, 0x07, 0x6b, 0x15, 0x7e, 0xa8, 0xfa, 0xee, 0xf7, 0x00, 0x4a, 0x99, 0xfc, 0xba,
+	0xde, 0xc3, 0xf9, 0x03, 0x0d, 0x6e, 0xee, 0xc6, 0x51, 0xc4, 0x29, 0x27, 0x94, 0x1a, 0x2e, 0x1f,
+	0x91, 0xf6, 0xd2, 0x47, 0xf4, 0x31, 0x34, 0x05, 0x32, 0xab, 0xd5, 0xdf, 0x5c, 0xa1, 0x32, 0x26,
+	0x39, 0xd0, 0x4b, 0xce, 0xdc, 0xc5, 0x34, 0xe1, 0x91, 0x1f, 0x44, 0xe7, 0xb9, 0x97, 0x9c, 0xb9,
+	0x8b, 0x63, 0x89, 0x71, 0xfe, 0x49, 0x03, 0xf8, 0x8a, 0xbb, 0x61, 0x76, 0x81, 0xde, 0x1e, 0xf5,
+	0x16, 0x44, 0x22, 0x73, 0x23, 0x2f, 0xcf, 0xc8, 0x0b, 0x18, 0x8d, 0x0f, 0x63, 0x11, 0x17, 0xd2,
+	0x09, 0x59, 0x2c, 0x07, 0x31, 0x3a, 0xe1, 0x76, 0x73, 0xa1, 0x62, 0x96, 0x82, 0xca, 0x50, 0xda,
+	0x20, 0xb4, 0x04, 0x70, 0x1d, 0xcc, 0x70, 0x83, 0x38, 0x22, 0xd3, 0xb0, 0x58, 0x0e, 0xe2, 0x3a,
+	0xf3, 0x24, 0x0b, 0x66, 0x32, 0x5e, 0x19, 0x4c, 0x41, 0x78, 0x2a, 0x8c, 0x5c, 0x43, 0xef, 0x22,
+	0xa6, 0xc7, 0x6b, 0xb0, 0x02, 0x76, 0xfe, 0x51, 0x83, 0x96, 0x74, 0x20, 0xb5, 0x98, 0xaa, 0xd5,
+	0x63, 0xea, 0x7b, 0x60, 0x25, 0x29, 0xf7, 0x03, 0x2f, 0x17, 0x9b, 0xc5, 0x4a, 0x04, 0x65, 0xad,
+	0x18, 0xc9, 0xe8, 0xf8, 0x26, 0x93, 0x00, 0x62, 0x45, 0xe2, 0x7a, 0x5c, 0x6d, 0x29, 0x01, 0x3c,
+	0xa3, 0x34, 0x42, 0x32, 0x3e, 0x93, 0x29, 0x08, 0x2b, 0x0a, 0xca, 0x52, 0x28, 0x8e, 0x5a, 0x44,
+	0x32, 0x11, 0x41, 0x01, 0xf4, 0x6d, 0x68, 0x23, 0x13, 0x7a, 0x56, 0x90, 0x29, 0x0c, 0x82, 0x13,
+	0xe1, 0xfc, 0x8d, 0x0e, 0xdd, 0xbd, 0x20, 0xe5, 0x5e, 0xc6, 0xfd, 0xa1, 0x7f, 0x4e, 0xcb, 0xf3,
+	0x28, 0x0b, 0xb2, 0x6b, 0x95, 0x2b, 0x28, 0xa8, 0x48, 0xe5, 0xf4, 0x7a, 0x69, 0x23, 0xad, 0xcc,
+	0xa0, 0x6a, 0x4c, 0x02, 0xf6, 0x36, 0x00, 0x0d, 0x64, 0x45, 0xd6, 0x78, 0x79, 0x45, 0x66, 0x11,
+	0x1b, 0x0e, 0x51, 0x72, 0x72, 0x4e, 0x20, 0xf3, 0x88, 0x16, 0x95, 0x6b, 0x73, 0x7c, 0xc9, 0x94,
+	0x1b, 0x9e, 0xf2, 0x90, 0x54, 0x42, 0xb9, 0xe1, 0x29, 0x0f, 0x8b, 0x8c, 0xbc, 0x2d, 0x8f, 0x83,
+	0x63, 0xfb, 0x03, 0xd0, 0xe3, 0x64, 0x60, 0x96, 0x1b, 0x56, 0x2f, 0xb6, 0x75, 0x94, 0x30, 0x3d,
+	0x4e, 0xd0, 0xbe, 0x65, 0xf9, 0x31, 0xb0, 0xd4, 0xeb, 0x46, 0x2f, 0x4c, 0xc9, 0x30, 0x53, 0x14,
+	0xe7, 0x2d, 0xd0, 0x8f, 0x12, 0xbb, 0x0d, 0xc6, 0x78, 0x38, 0xe9, 0xdf, 0xc0, 0xc1, 0xde, 0xf0,
+	0xa0, 0xaf, 0x39, 0xdf, 0xe9, 0x60, 0x1d, 0xce, 0x33, 0x17, 0x5f, 0x8b, 0x78, 0x95, 0xb6, 0xdf,
+	0x01, 0x53, 0x64, 0x6e, 0x4a, 0x91, 0x4c, 0xfa, 0xd5, 0x36, 0xc1, 0x13, 0x61, 0x7f, 0x04, 0x4d,
+	0xee, 0x9f, 0xf3, 0xdc, 0xdd, 0xf5, 0x97, 0xcf, 0xc9, 0x24, 0xd9, 0xde, 0x84, 0x96, 0xf0, 0x2e,
+	0xf8, 0xcc, 0x1d, 0x34, 0x4a, 0xc6, 0x31, 0x61, 0x64, 0x02, 0xc5, 0x14, 0xdd, 0xfe, 0x10, 0x9a,
+	0x28, 0x69, 0x31, 0x68, 0x95, 0xc9, 0x3d, 0x0a, 0x55, 0xb1, 0x49, 0xa2, 0xfd, 0x00, 0xda, 0x7e,
+	0x1a, 0x27, 0xd3, 0x38, 0x21, 0x99, 0xad, 0x6d, 0xdf, 0xa2, 0x57, 0x9b, 0xdf, 0x66, 0x6b, 0x2f,
+	0x8d, 0x93, 0xa3, 0x84, 0xb5, 0x7c, 0xfa, 0xc5, 0xaa, 0x8c, 0xd8, 0xa5, 0x7e, 0xa5, 0x9b, 0xb3,
+	0x10, 0x23, 0xab, 0xf0, 0x4d, 0x30, 0x67, 0x3c, 0x73, 0x7d, 0x37, 0x73, 0x95, 0xb7, 0xa3, 0x0a,
+	0xe1, 0x50, 0xe1, 0x58, 0x41, 0x75, 0x1e, 0x42, 0x4b, 0x2e, 0x6d, 0x9b, 0xd0, 0x18, 0x1d, 0x8d,
+	0x86, 0x52, 0xa0, 0x3b, 0x07, 0x07, 0x7d, 0x0d, 0x51, 0x7b, 0x3b, 0x93, 0x9d, 0xbe, 0x8e, 0xa3,
+	0xc9, 0xcf, 0x8e, 0x87, 0x7d, 0xc3, 0xf9, 0x37, 0x0d, 0xcc, 0x7c, 0x1d, 0xfb, 0x4b, 0x00, 0x7c,
+	0x25, 0xd3, 0x8b, 0x20, 0x2a, 0x92, 0x82, 0x77, 0xab, 0x3b, 0x6d, 0x1d, 0xa7, 0xdc, 0xff, 0x0a,
+	0xa9, 0x32, 0x3c, 0x58, 0x49, 0x0e, 0xaf, 0x8f, 0x61, 0xad, 0x4e, 0x5c, 0x91, 0x1d, 0xdd, 0xaf,
+	0xfa, 0xc9, 0xb5, 0xed, 0x1f, 0xd4, 0x96, 0xc6, 0x99, 0x64, 0xa8, 0x15, 0x97, 0xf9, 0x00, 0xcc,
+	0x1c, 0x6d, 0x77, 0xa0, 0xbd, 0x37, 0x7c, 0xb2, 0x73, 0x72, 0x80, 0x46, 0x02, 0xd0, 0x1a, 0xef,
+	0x8f, 0x9e, 0x1e, 0x0c, 0xe5, 0xb5, 0x0e, 0xf6, 0xc7, 0x93, 0xbe, 0xee, 0xfc, 0xb9, 0x06, 0x66,
+	0x1e, 0x83, 0xed, 0x8f, 0x31, 0x78, 0x52, 0xa8, 0x1f, 0x68, 0x65, 0x31, 0x5d, 0x29, 0x05, 0x58,
+	0x4e, 0x47, 0xa3, 0x0f, 0x22, 0x9f, 0x2f, 0xf2, 0xa8, 0x4c, 0x40, 0xb5, 0x10, 0x31, 0x6a, 0xb5,
+	0x30, 0xd6, 0x54, 0x71, 0xc4, 0x55, 0x92, 0x45, 0x63, 0xb2, 0xc1, 0x20, 0xf2, 0xe8, 0xcd, 0x37,
+	0x95, 0x0d, 0x22, 0x3c, 0x11, 0xce, 0xbf, 0xea, 0x60, 0x16, 0x89, 0xd7, 0x7d, 0xb0, 0x66, 0xb9,
+	0x15, 0x28, 0x87, 0xde, 0xab, 0x99, 0x06, 0x2b, 0xe9, 0xf6, 0x5b, 0xa0, 0x5f, 0x5e, 0x29, 0x8b,
+	0x6c, 0x21, 0xd7, 0xb3, 0xe7, 0x4c, 0xbf, 0xbc, 0x2a, 0x23, 0x42, 0xf3, 0xb5, 0x11, 0xe1, 0x1e,
+	0xdc, 0xf4, 0x42, 0xee, 0x46, 0xd3, 0xd2, 0x1f, 0xca, 0x97, 0xbd, 0x46, 0xe8, 0xe3, 0x1c, 0x9b,
+	0x6b, 0xab, 0x5d, 0x6a, 0xeb, 0x2e, 0x34, 0x7d, 0x1e, 0x66, 0x6e, 0xb5, 0x17, 0x71, 0x94, 0xba,
+	0x5e, 0xc8, 0xf7, 0x10, 0xcd, 0x24, 0x15, 0x8d, 0x33, 0xcf, 0x0a, 0xab, 0xc6, 0x99, 0xeb, 0x81,
+	0x15, 0xd4, 0x52, 0xcc, 0x50, 0x15, 0xf3, 0x7d, 0x78, 0x83, 0x2f, 0x12, 0x7a, 0x91, 0xd3, 0x22,
+	0x83, 0xef, 0x10, 0x47, 0x3f, 0x27, 0xec, 0x2a, 0xbc, 0xf3, 0x29, 0x18, 0xcf, 0x9e, 0x8f, 0x95,
+	0x60, 0xb4, 0x17, 0x04, 0x93, 0x6b, 0x46, 0x2f, 0x35, 0xe3, 0xfc, 0x9f, 0x01, 0x6d, 0xe5, 0x0b,
+	0xf1, 0x92, 0xf3, 0xa2, 0x2e, 0xc3, 0x61, 0x3d, 0x21, 0x2b, 0x9c, 0x6a, 0xb5, 0xc9, 0x65, 0xbc,
+	0xbe, 0xc9, 0x65, 0x7f, 0x09, 0xdd, 0x44, 0xd2, 0xaa, 0x6e, 0xf8, 0xed, 0xea, 0x1c, 0xf5, 0x4b,
+	0xf3, 0x3a, 0x49, 0x09, 0xa0, 0xe5, 0x50, 0x07, 0x20, 0x73, 0xcf, 0x49, 0x9f, 0x5d, 0xd6, 0x46,
+	0x78, 0xe2, 0x9e, 0xbf, 0xc4, 0x19, 0xff, 0x0a, 0x3e, 0x15, 0xeb, 0xcf, 0x38, 0x19, 0x74, 0xc9,
+	0x4f, 0xa2, 0x1f, 0xae, 0xba, 0xc8, 0x5e, 0xdd, 0x45, 0xbe, 0x0b, 0x96, 0x17, 0xcf, 0x66, 0x01,
+	0xd1, 0xd6, 0x54, 0xe5, 0x44, 0x88, 0x89, 0x70, 0xfe, 0x58, 0x83, 0xb6, 0xba, 0xed, 0x0b, 0x0f,
+	0xf0, 0xf1, 0xfe, 0x68, 0x87, 0xfd, 0xac, 0xaf, 0xa1, 0x83, 0xd9, 0x1f, 0x4d, 0xfa, 0xba, 0x6d,
+	0x41, 0xf3, 0xc9, 0xc1, 0xd1, 0xce, 0xa4, 0x6f, 0xe0, 0xa3, 0x7c, 0x7c, 0x74, 0x74, 0xd0, 0x6f,
+	0xd8, 0x5d, 0x30, 0xf7, 0x76, 0x26, 0xc3, 0xc9, 0xfe, 0xe1, 0xb0, 0xdf, 0x44, 0xde, 0xa7, 0xc3,
+	0xa3, 0x7e, 0x0b, 0x07, 0x27, 0xfb, 0x7b, 0xfd, 0x36, 0xd2, 0x8f, 0x77, 0xc6, 0xe3, 0x6f, 0x8e,
+	0xd8, 0x5e, 0xdf, 0xa4, 0x87, 0x3d, 0x61, 0xfb, 0xa3, 0xa7, 0x7d, 0x0b, 0xc7, 0x47, 0x8f, 0xbf,
+	0x1e, 0xee, 0x4e, 0xfa, 0xe0, 0x7c, 0x0a, 0x9d, 0x8a, 0x04, 0x71, 0x36, 0x1b, 0x3e, 0xe9, 0xdf,
+	0xc0, 0x2d, 0x9f, 0xef, 0x1c, 0x9c, 0xa0, 0x1f, 0x58, 0x03, 0xa0, 0xe1, 0xf4, 0x60, 0x67, 0xf4,
+	0xb4, 0xaf, 0x3b, 0x3f, 0x05, 0xf3, 0x24, 0xf0, 0x1f, 0x87, 0xb1, 0x77, 0x89, 0x86, 0x71, 0xea,
+	0x0a, 0xae, 0x92, 0x36, 0x1a, 0x63, 0xec, 0x25, 0x0b, 0x16, 0x4a, 0xf7, 0x0a, 0x42, 0x59, 0x45,
+	0xf3, 0xd9, 0x94, 0x1a, 0xa3, 0x86, 0x8c, 0x34, 0xd1, 0x7c, 0x76, 0x82, 0xbd, 0xd1, 0x11, 0xb4,
+	0x4f, 0x02, 0xff, 0xd8, 0xf5, 0x2e, 0xd1, 0x65, 0x9f, 0xe2, 0xd2, 0x53, 0x11, 0x7c, 0xcb, 0x55,
+	0x44, 0xb2, 0x08, 0x33, 0x0e, 0xbe, 0xe5, 0xf6, 0x87, 0xd0, 0x22, 0x20, 0x4f, 0xd0, 0xe9, 0x4d,
+	0xe4, 0xc7, 0x61, 0x8a, 0xe6, 0xfc, 0x89, 0x56, 0x5c, 0x8b, 0x3a, 0x5f, 0x77, 0xa0, 0x91, 0xb8,
+	0xde, 0xe5, 0x40, 0x2b, 0x53, 0x5a, 0xb5, 0x1f, 0x23, 0x82, 0x7d, 0x0f, 0x4c, 0x65, 0x3b, 0xf9,
+	0xc2, 0x9d, 0x8a, 0x91, 0xb1, 0x82, 0x58, 0xd7, 0xaa, 0x51, 0xd7, 0x2a, 0x25, 0x70, 0x49, 0x18,
+	0x50, 0x13, 0xc3, 0x40, 0xc7, 0x26, 0x21, 0xe7, 0x73, 0x80, 0xb2, 0xd9, 0xb8, 0xc2, 0x7f, 0xdf,
+	0x82, 0xa6, 0x1b, 0x06, 0x6e, 0x9e, 0x10, 0x4a, 0xc0, 0x19, 0x41, 0xa7, 0x9c, 0x45, 0xe2, 0x73,
+	0xc3, 0x70, 0x7a, 0xc9, 0xaf, 0x05, 0xcd, 0x35, 0x59, 0xdb, 0x0d, 0xc3, 0x67, 0xfc, 0x5a, 0x60,
+	0xec, 0x94, 0xdd, 0x4d, 0x7d, 0xa9, 0x31, 0x46, 0x53, 0x99, 0x24, 0x3a, 0x3f, 0x82, 0xd6, 0x13,
+	0x69, 0xc5, 0xa5, 0xa5, 0x6b, 0x2f, 0xcd, 0x1e, 0xbe, 0x00, 0x28, 0x7b, 0x6b, 0xf6, 0x7d, 0xd5,
+	0x45, 0x15, 0xb2, 0x67, 0xab, 0x95, 0x25, 0x85, 0x64, 0x52, 0x0d, 0x54, 0x62, 0x76, 0xf6, 0xc0,
+	0x7c, 0x65, 0x5f, 0x5a, 0x09, 0x40, 0x2f, 0x05, 0xb0, 0xa2, 0x53, 0xed, 0xfc, 0x02, 0xa0, 0xec,
+	0xb6, 0xaa, 0x87, 0x27, 0x57, 0xc1, 0x87, 0xf7, 0x09, 0xb6, 0x25, 0x82, 0xd0, 0x4f, 0x79, 0x54,
+	0xbb, 0x75, 0x31, 0x83, 0x15, 0x74, 0x7b, 0x03, 0x1a, 0xd4, 0x44, 0x36, 0x4a, 0x2f, 0x9a, 0x9f,
+	0x8f, 0x11, 0xc5, 0x59, 0x40, 0x4f, 0x26, 0x25, 0x8c, 0xff, 0xde, 0x9c, 0x8b, 0x57, 0xe6, 0xc0,
+	0xb7, 0x65, 0x30, 0x27, 0xef, 0x9e, 0xb7, 0xc3, 0x2b, 0x18, 0x34, 0x82, 0xb3, 0x80, 0x87, 0x7e,
+	0x7e, 0x1b, 0x05, 0xa1, 0x92, 0x65, 0x82, 0xd3, 0x20, 0xb4, 0x04, 0x9c, 0x3f, 0xd4, 0x01, 0xe4,
+	0xd6, 0xd8, 0x87, 0xa8, 0x27, 0xd8, 0xda, 0x72, 0x82, 0x6d, 0x43, 0xa3, 0xf8, 0x3e, 0x60, 0x31,
+	0x1a, 0x97, 0xce, 0x5f, 0x25, 0xdd, 0x04, 0xe0, 0x3a, 0x59, 0x7c, 0xc9, 0xa3, 0xe0, 0x5b, 0x9e,
+	0xaa, 0x0d, 0x4b, 0x44, 0xb5, 0x5b, 0xde, 0xac, 0x77, 0xcb, 0x8b, 0x96, 0x62, 0x4b, 0xae, 0x46,
+	0xc0, 0xaa, 0xee, 0xa8, 0x2c, 0x32, 0x04, 0x4f, 0xb3, 0x3c, 0x81, 0x97, 0x50, 0x91, 0xd2, 0x5a,
+	0x8a, 0x17, 0x53, 0xda, 0x3b, 0xd0, 0x89, 0xf0, 0x4b, 0x40, 0x74, 0x16, 0x06, 0x5e, 0xa6, 0xba,
+	0xe3, 0x10, 0xc5, 0xbb, 0x0a, 0xe3, 0x7c, 0x09, 0xdd, 0x5c, 0xfe, 0xd4, 0x84, 0xfc, 0xa4, 0x48,
+	0x1b, 0xb5, 0x52, 0xb7, 0xa5, 0x98, 0x1e, 0xeb, 0x03, 0x2d, 0x4f, 0x1c, 0x9d, 0xff, 0x35, 0xf2,
+	0xc9, 0xaa, 0x25, 0xf7, 0x6a, 0x19, 0xd6, 0xf3, 0x7a, 0xfd, 0x57, 0xca, 0xeb, 0x7f, 0x02, 0x96,
+	0x4f, 0xc9, 0x6d, 0x70, 0x95, 0xc7, 0xad, 0xf5, 0xe5, 0x44, 0x56, 0xa5, 0xbf, 0xc1, 0x15, 0x67,
+	0x25, 0xf3, 0x6b, 0xf4, 0x50, 0x48, 0xbb, 0xb9, 0x4a, 0xda, 0xad, 0x5f, 0x53, 0xda, 0xef, 0x43,
+	0x37, 0x8a, 0xa3, 0x69, 0x34, 0x0f, 0x43, 0x2c, 0xe8, 0x94, 0xb8, 0x3b, 0x51, 0x1c, 0x8d, 0x14,
+	0x0a, 0xbb, 0x96, 0x55, 0x16, 0xf9, 0xa8, 0x3b, 0xb2, 0x6b, 0x59, 0xe1, 0xa3, 0xa7, 0xbf, 0x09,
+	0xfd, 0xf8, 0xf4, 0x17, 0xd8, 0xa0, 0x47, 0x89, 0x4d, 0xe9, 0x35, 0x77, 0x65, 0xaa, 0x23, 0xf1,
+	0x28, 0xa2, 0x11, 0xbe, 0xeb, 0x25, 0x35, 0xf7, 0x5e, 0x50, 0xf3, 0x17, 0x60, 0x15, 0x52, 0xaa,
+	0x24, 0xd2, 0x16, 0x34, 0xf7, 0x47, 0x7b, 0xc3, 0xdf, 0xe9, 0x6b, 0x18, 0x0b, 0xd9, 0xf0, 0xf9,
+	0x90, 0x8d, 0x87, 0x7d, 0x1d, 0xe3, 0xd4, 0xde, 0xf0, 0x60, 0x38, 0x19, 0xf6, 0x8d, 0xaf, 0x1b,
+	0x66, 0xbb, 0x6f, 0x52, 0x4f, 0x2e, 0x0c, 0xbc, 0x20, 0x73, 0xc6, 0x00, 0x65, 0x75, 0x80, 0x5e,
+	0xb9, 0x3c, 0x9c, 0x2a, 0xb8, 0xb3, 0xfc, 0x58, 0x9b, 0xc5, 0x83, 0xd4, 0x5f, 0x56, 0x83, 0x48,
+	0xba, 0x73, 0x02, 0xe6, 0xa1, 0x9b, 0xbc, 0x90, 0x65, 0x77, 0x8b, 0x0e, 0xd6, 0x5c, 0xb5, 0x99,
+	0x55, 0x92, 0x73, 0x17, 0xda, 0x2a, 0x30, 0x28, 0xdf, 0x52, 0x0b, 0x1a, 0x39, 0xcd, 0xf9, 0x3b,
+	0x0d, 0x6e, 0x1d, 0xc6, 0x57, 0xbc, 0x48, 0x0a, 0x8f, 0xdd, 0xeb, 0x30, 0x76, 0xfd, 0xd7, 0x58,
+	0xea, 0x0f, 0x01, 0x44, 0x3c, 0xa7, 0x7e, 0x71, 0xd1, 0xdd, 0xb6, 0x24, 0xe6, 0xa9, 0xfa, 0xbc,
+	0xc6, 0x45, 0x46, 0x44, 0x15, 0x4e, 0x11, 0x46, 0xd2, 0x0f, 0xa0, 0x95, 0x2d, 0xa2, 0xb2, 0x99,
+	0xde, 0xcc, 0xa8, 0x63, 0xb4, 0x32, 0x23, 0x6c, 0xbe, 0x24, 0x23, 0xdc, 0x05, 0x6b, 0xb2, 0xa0,
+	0x6e, 0xca, 0x5c, 0xd4, 0xd2, 0x1c, 0xed, 0x15, 0x69, 0x8e, 0xbe, 0x94, 0xe6, 0xfc, 0xb7, 0x06,
+	0x9d, 0x4a, 0x6a, 0x6b, 0xbf, 0x0f, 0x8d, 0x6c, 0x11, 0xd5, 0x3f, 0x59, 0xe5, 0x9b, 0x30, 0x22,
+	0xa1, 0xf5, 0x62, 0xab, 0xc5, 0x15, 0x22, 0x38, 0x8f, 0xb8, 0xaf, 0x96, 0xc4, 0xf6, 0xcb, 0x8e,
+	0x42, 0xd9, 0x07, 0x70, 0x53, 0x3a, 0xe7, 0xfc, 0x12, 0x79, 0x19, 0xfa, 0xc1, 0x52, 0x2a, 0x2d,
+	0x3b, 0x4e, 0xf9, 0x95, 0x54, 0x6d, 0xb5, 0x76, 0x5e, 0x43, 0xae, 0xef, 0xc0, 0x9b, 0x2b, 0xd8,
+	0xbe, 0x57, 0x8f, 0xf1, 0x0e, 0xf4, 0xb0, 0x27, 0x17, 0xcc, 0xb8, 0xc8, 0xdc, 0x59, 0x42, 0x69,
+	0xa2, 0x0a, 0xae, 0x0d, 0xa6, 0x67, 0xc2, 0xf9, 0x08, 0xba, 0xc7, 0x9c, 0xa7, 0x8c, 0x8b, 0x24,
+	0x8e, 0x64, 0x8a, 0xa4, 0x3a, 0x3d, 0x32, 0x92, 0x2b, 0xc8, 0xf9, 0x5d, 0xb0, 0xb0, 0x90, 0x7a,
+	0xec, 0x66, 0xde, 0xc5, 0xf7, 0x29, 0xb4, 0x3e, 0x82, 0x76, 0x22, 0x6d, 0x4a, 0xd5, 0x3e, 0x5d,
+	0x8a, 0xe8, 0xca, 0xce, 0x58, 0x4e, 0x74, 0x3e, 0x85, 0x37, 0xc7, 0xf3, 0x53, 0xe1, 0xa5, 0x41,
+	0x42, 0xd1, 0x4f, 0x45, 0xbb, 0x75, 0x30, 0x93, 0x94, 0x9f, 0x05, 0x0b, 0xf5, 0x35, 0xb1, 0xcb,
+	0x0a, 0xd8, 0xf9, 0x1c, 0x6e, 0xd5, 0xa7, 0xa8, 0x2b, 0xbc, 0x07, 0xc6, 0xe5, 0x95, 0xa8, 0xb6,
+	0xd7, 0x9e, 0x3d, 0xa7, 0x4f, 0x44, 0x88, 0x76, 0x18, 0x18, 0xa3, 0xf9, 0xac, 0xfa, 0x99, 0xbb,
+	0x21, 0x3f, 0x73, 0xd7, 0xfa, 0x3b, 0xfa, 0x52, 0x7f, 0xe7, 0x3d, 0xb0, 0xce, 0xe2, 0xf4, 0xf7,
+	0xdd, 0xd4, 0xe7, 0xbe, 0x8a, 0x67, 0x25, 0xc2, 0xf9, 0x39, 0x74, 0x72, 0x13, 0xd8, 0xf7, 0xa9,
+	0x2b, 0x4f, 0x36, 0xb8, 0xef, 0xd7, 0x4c, 0x52, 0xf6, 0x5a, 0x78, 0xe4, 0xef, 0xe7, 0xb6, 0x23,
+	0x81, 0xfa, 0xce, 0xaa, 0x99, 0x9a, 0xef, 0xec, 0x3c, 0x81, 0x6e, 0x5e, 0x58, 0x61, 0xe1, 0x4c,
+	0x56, 0x1d, 0x06, 0x3c, 0xaa, 0x58, 0xbc, 0x29, 0x11, 0x13, 0xf1, 0x8a, 0x8f, 0x4e, 0xce, 0x16,
+	0xb4, 0xd4, 0x93, 0xb1, 0xa1, 0xe1, 0xc5, 0xbe, 0x7c, 0xd6, 0x4d, 0x46, 0x63, 0x14, 0xc7, 0x4c,
+	0x9c, 0xe7, 0x89, 0xcf, 0x4c, 0x9c, 0x3b, 0xff, 0xa0, 0x43, 0xef, 0xb1, 0xeb, 0x5d, 0xce, 0x93,
+	0x5c, 0x17, 0x95, 0xea, 0x58, 0xab, 0x55, 0xc7, 0xd5, 0x4a, 0x58, 0xaf, 0x55, 0xc2, 0xb5, 0x03,
+	0x19, 0xf5, 0x6c, 0xe5, 0x6d, 0x68, 0xcf, 0xa3, 0x60, 0x91, 0xfb, 0x02, 0x8b, 0xb5, 0x10, 0x9c,
+	0x08, 0x7b, 0x03, 0x3a, 0xe8, 0x2e, 0x82, 0x88, 0x6a, 0x62, 0xd5, 0x42, 0xac, 0xa2, 0xd0, 0xff,
+	0xb8, 0x9e, 0xc7, 0x85, 0xc0, 0x9c, 0x53, 0x95, 0x4a, 0x96, 0xc4, 0x3c, 0xe3, 0xd7, 0x48, 0x16,
+	0xdc, 0x4b, 0x79, 0x36, 0x2d, 0xeb, 0x5b, 0x4b, 0x62, 0x90, 0xfc, 0x01, 0xf4, 0x04, 0x17, 0xd8,
+	0x8f, 0x9c, 0x52, 0xc0, 0x53, 0xdd, 0x97, 0xae, 0x42, 0x4e, 0x10, 0x87, 0x0a, 0x77, 0xa3, 0x38,
+	0xba, 0x9e, 0xc5, 0x73, 0xa1, 0x62, 0x58, 0x89, 0x58, 0xca, 0xb4, 0x60, 0x39, 0xd3, 0x72, 0xfe,
+	0x42, 0x83, 0xde, 0x70, 0x91, 0xd0, 0xb7, 0xcb, 0xd7, 0xa6, 0x6d, 0x15, 0xb9, 0xea, 0x35, 0xb9,
+	0x56, 0x24, 0x64, 0xa8, 0x76, 0xa9, 0x94, 0x10, 0x26, 0x72, 0x71, 0x3a, 0x73, 0xb3, 0x5c, 0x72,
+	0x12, 0x7a, 0xbd, 0xe4, 0x9c, 0x3f, 0xd5, 0xc1, 0x92, 0x5a, 0x45, 0x49, 0x7c, 0xac, 0xb2, 0x36,
+	0xad, 0x6c, 0xce, 0x14, 0xc4, 0xad, 0x67, 0xfc, 0x9a, 0xb2, 0x0d, 0x62, 0x59, 0xd9, 0x9e, 0x54,
+	0x61, 0x47, 0xd6, 0x1a, 0x38, 0x44, 0xe3, 0x94, 0xde, 0x18, 0xf1, 0xea, 0x9b, 0x1c, 0x21, 0xf0,
+	0x5f, 0x17, 0x98, 0x23, 0xf2, 0x74, 0xa6, 0x8e, 0x45, 0xe3, 0x7a, 0x56, 0xd7, 0x53, 0x79, 0x86,
+	0x73, 0x01, 0x6d, 0xb5, 0x3b, 0x86, 0xdd, 0x93, 0xd1, 0xb3, 0xd1, 0xd1, 0x37, 0xa3, 0xfe, 0x8d,
+	0xa2, 0x9d, 0xa5, 0x95, 0x81, 0x59, 0xaf, 0x06, 0x66, 0x03, 0xf1, 0xbb, 0x47, 0x27, 0xa3, 0x49,
+	0xbf, 0x61, 0xf7, 0xc0, 0xa2, 0xe1, 0x94, 0x0d, 0x9f, 0xf7, 0x9b, 0x54, 0x66, 0xee, 0x7e, 0x35,
+	0x3c, 0xdc, 0xe9, 0xb7, 0x8a, 0x66, 0x58, 0xdb, 0xf9, 0x23, 0x0d, 0xde, 0x90, 0x57, 0xae, 0x16,
+	0x65, 0xd5, 0x3f, 0xc9, 0x34, 0xe4, 0x9f, 0x64, 0x7e, 0xb3, 0x75, 0xd8, 0xf6, 0x3f, 0x6b, 0xd0,
+	0x40, 0xff, 0x69, 0x3f, 0x00, 0xeb, 0x2b, 0xee, 0xa6, 0xd9, 0x29, 0x77, 0x33, 0xbb, 0xe6, 0x2b,
+	0xd7, 0x29, 0xd5, 0x2c, 0x5b, 0xf9, 0xce, 0x8d, 0x47, 0x9a, 0xbd, 0x25, 0x3f, 0x75, 0xe7, 0x5f,
+	0xf0, 0x7b, 0xb9, 0x1f, 0x26, 0x3f, 0xbd, 0x5e, 0x9b, 0xef, 0xdc, 0xd8, 0x24, 0xfe, 0xaf, 0xe3,
+	0x20, 0xda, 0x95, 0xdf, 0x7f, 0xed, 0x65, 0xbf, 0xbd, 0x3c, 0xc3, 0x7e, 0x00, 0xad, 0x7d, 0x71,
+	0xcc, 0x57, 0xb1, 0x52, 0xb2, 0x52, 0x8d, 0x1d, 0xce, 0x8d, 0xed, 0xbf, 0x35, 0xa0, 0x81, 0xdf,
+	0x4d, 0xec, 0x1f, 0x41, 0x5b, 0x7d, 0xf8, 0xb0, 0x2b, 0x1f, 0x38, 0xd6, 0x29, 0x9d, 0x5d, 0xfa,
+	0x22, 0x42, 0xbb, 0xf4, 0x65, 0xbe, 0x53, 0xb6, 0xb4, 0xec, 0xf2, 0xbb, 0xcc, 0x0b, 0x87, 0xfa,
+	0x02, 0xfa, 0xe3, 0x2c, 0xe5, 0xee, 0xac, 0xc2, 0x5e, 0x17, 0xd5, 0xaa, 0xfe, 0x18, 0xc9, 0xeb,
+	0x3e, 0xb4, 0x64, 0x14, 0x5e, 0x9a, 0xb0, 0xdc, 0xea, 0x22, 0xe6, 0x7b, 0xd0, 0x19, 0x5f, 0xc4,
+	0xf3, 0xd0, 0x1f, 0xf3, 0xf4, 0x8a, 0xdb, 0x95, 0x4f, 0x99, 0xeb, 0x95, 0xb1, 0x73, 0xc3, 0xde,
+	0x04, 0x90, 0xfe, 0x1f, 0x5b, 0x06, 0x76, 0x1b, 0x69, 0xa3, 0xf9, 0x4c, 0x2e, 0x5a, 0x09, 0x0c,
+	0x92, 0xb3, 0x12, 0x8c, 0x5f, 0xc5, 0xf9, 0x19, 0xf4, 0x76, 0xc9, 0x6a, 0x8e, 0xd2, 0x9d, 0xd3,
+	0x38, 0xcd, 0xec, 0xe5, 0xcf, 0x99, 0xeb, 0xcb, 0x08, 0xe7, 0x86, 0xfd, 0x08, 0xcc, 0x49, 0x7a,
+	0x2d, 0xf9, 0xdf, 0x50, 0x39, 0x4c, 0xb9, 0xdf, 0x8a, 0x5b, 0x6e, 0xff, 0x59, 0x03, 0x5a, 0xdf,
+	0xc4, 0xe9, 0x25, 0x4f, 0xb1, 0xb4, 0xa1, 0x9e, 0xa4, 0x32, 0xa3, 0xa2, 0x3f, 0xb9, 0x6a, 0xa3,
+	0x0f, 0xc1, 0x22, 0xa1, 0xe0, 0xdf, 0x7a, 0xa4, 0xaa, 0xe8, 0x0f, 0x5a, 0x52, 0x2e, 0xb2, 0x54,
+	0x22, 0xbd, 0xae, 0x49, 0x45, 0x15, 0x2d, 0xda, 0x5a, 0xa3, 0x70, 0xbd, 0x2d, 0x83, 0xf3, 0x18,
+	0x4d, 0xf3, 0x91, 0x86, 0xee, 0x68, 0x2c, 0x6f, 0x8a, 0x4c, 0xe5, 0x1f, 0x53, 0xd6, 0xd7, 0x72,
+	0x44, 0xb1, 0xf2, 0x43, 0x68, 0xc9, 0x3c, 0x59, 0x5e, 0xb3, 0x56, 0x22, 0xaf, 0xf7, 0xab, 0x28,
+	0x35, 0xe1, 0x63, 0x68, 0xc9, 0x77, 0x2e, 0x27, 0xd4, 0x22, 0x9b, 0x3c, 0xb5, 0x8c, 0x8e, 0x92,
+	0x55, 0xfa, 0x6e, 0xc9, 0x5a, 0xf3, 0xe3, 0x4b, 0xac, 0x0f, 0xa0, 0xcf, 0xb8, 0xc7, 0x83, 0x4a,
+	0x06, 0x6d, 0xe7, 0x97, 0x5a, 0xf1, 0xfa, 0xbe, 0x80, 0x5e, 0x2d, 0xdb, 0xb6, 0x07, 0x24, 0xe8,
+	0x15, 0x09, 0xf8, 0x0b, 0x36, 0xff, 0x39, 0x58, 0x2a, 0xd9, 0x39, 0xe5, 0x36, 0xb5, 0x19, 0x57,
+	0xa4, 0x4b, 0xeb, 0x95, 0x6c, 0x87, 0x2c, 0xf8, 0xc7, 0x60, 0x4f, 0x52, 0x37, 0x12, 0x67, 0x3c,
+	0x3d, 0xa0, 0xff, 0x93, 0xd0, 0x5b, 0x79, 0xdd, 0xab, 0x7f, 0xdc, 0xff, 0x97, 0xef, 0x6e, 0x6b,
+	0xff, 0xfe, 0xdd, 0x6d, 0xed, 0x3f, 0xbf, 0xbb, 0xad, 0xfd, 0xf2, 0xbf, 0x6e, 0xdf, 0x38, 0x6d,
+	0xd1, 0xbf, 0x08, 0x3f, 0xfb, 0xff, 0x01, 0x00, 0x87, 0x3a, 0x5d, 0x1d, 0x89, 0x28, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -5475,6 +5484,8 @@ type WorkerClient interface {
 	ReceivePredicate(ctx context.Context, opts ...grpc.CallOption) (Worker_ReceivePredicateClient, error)
 	MovePredicate(ctx context.Context, in *MovePredicatePayload, opts ...grpc.CallOption) (*api.Payload, error)
 	Subscribe(ctx context.Context, in *SubscriptionRequest, opts ...grpc.CallOption) (Worker_SubscribeClient, error)
+	// TransferLeadership makes the member with the id in RaftContext the leader of the group.
+	TransferLeadership(ctx context.Context, in *RaftContext, opts ...grpc.CallOption) (*api.Payload, error)
 }
 
 type workerClient struct {
@@ -5645,6 +5656,15 @@ func (x *workerSubscribeClient) Recv() (*pb.KVList, error) {
 	return m, nil
 }
 
+func (c *workerClient) TransferLeadership(ctx context.Context, in *RaftContext, opts ...grpc.CallOption) (*api.Payload, error) {
+	out := new(api.Payload)
+	err := c.cc.Invoke(ctx, "/pb.Worker/TransferLeadership", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WorkerServer is the server API for Worker service.
 type WorkerServer interface {
 	// Data serving RPCs.
@@ -5658,6 +5678,8 @@ type WorkerServer interface {
 	ReceivePredicate(Worker_ReceivePredicateServer) error
 	MovePredicate(context.Context, *MovePredicatePayload) (*api.Payload, error)
 	Subscribe(*SubscriptionRequest, Worker_SubscribeServer) error
+	// TransferLeadership makes the member with the id in RaftContext the leader of the group.
+	TransferLeadership(context.Context, *RaftContext) (*api.Payload, error)
 }
 
 // UnimplementedWorkerServer can be embedded to have forward compatible implementations.
@@ -5694,6 +5716,9 @@ func (*UnimplementedWorkerServer) MovePredicate(ctx context.Context, req *MovePr
 func (*UnimplementedWorkerServer) Subscribe(req *SubscriptionRequest, srv Worker_SubscribeServer) error {
 	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
 }
+func (*UnimplementedWorkerServer) TransferLeadership(ctx context.Context, req *RaftContext) (*api.Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferLeadership not implemented")
+}
 
 func RegisterWorkerServer(s *grpc.Server, srv WorkerServer) {
 	s.RegisterService(&_Worker_serviceDesc, srv)
@@ -5898,6 +5923,24 @@ func (x *workerSubscribeServer) Send(m *pb.KVList) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Worker_TransferLeadership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RaftContext)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).TransferLeadership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Worker/TransferLeadership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).TransferLeadership(ctx, req.(*RaftContext))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Worker_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "pb.Worker",
 	HandlerType: (*WorkerServer)(nil),
@@ -5930,6 +5973,10 @@ var _Worker_serviceDesc = grpc.ServiceDesc{
 			MethodName: "MovePredicate",
 			Handler:    _Worker_MovePredicate_Handler,
 		},
+		{
+			MethodName: "TransferLeadership",
+			Handler:    _Worker_TransferLeadership_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -9428,6 +9475,13 @@ func (m *ExportRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Format) > 0 {
 		i -= len(m.Format)
 		copy(dAtA[i:], m.Format)
@@ -11198,6 +11252,10 @@ func (m *ExportRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPb(uint64(l))
 	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -20953,6 +21011,38 @@ func (m *ExportRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Format = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
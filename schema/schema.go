@@ -442,6 +442,10 @@ func InitialTypes() []*pb.TypeUpdate {
 				Predicate: "dgraph.graphql.schema",
 				ValueType: pb.Posting_STRING,
 			},
+			{
+				Predicate: "dgraph.graphql.schema_updated_at",
+				ValueType: pb.Posting_DATETIME,
+			},
 		},
 	})
 
@@ -476,6 +480,9 @@ func initialSchemaInternal(all bool) []*pb.SchemaUpdate {
 	}, &pb.SchemaUpdate{
 		Predicate: "dgraph.graphql.schema",
 		ValueType: pb.Posting_STRING,
+	}, &pb.SchemaUpdate{
+		Predicate: "dgraph.graphql.schema_updated_at",
+		ValueType: pb.Posting_DATETIME,
 	})
 
 	if all || x.WorkerConfig.AclEnabled {
@@ -498,6 +505,14 @@ func initialSchemaInternal(all bool) []*pb.SchemaUpdate {
 				ValueType: pb.Posting_UID,
 				List:      true,
 			},
+			{
+				Predicate: "dgraph.user.disabled",
+				ValueType: pb.Posting_BOOL,
+			},
+			{
+				Predicate: "dgraph.user.totp",
+				ValueType: pb.Posting_STRING,
+			},
 			{
 				Predicate: "dgraph.acl.rule",
 				ValueType: pb.Posting_UID,
@@ -514,6 +529,18 @@ func initialSchemaInternal(all bool) []*pb.SchemaUpdate {
 				Predicate: "dgraph.rule.permission",
 				ValueType: pb.Posting_INT,
 			},
+			{
+				Predicate: "dgraph.rule.deny",
+				ValueType: pb.Posting_BOOL,
+			},
+			{
+				Predicate: "dgraph.rule.filter",
+				ValueType: pb.Posting_STRING,
+			},
+			{
+				Predicate: "dgraph.group.default_permission",
+				ValueType: pb.Posting_INT,
+			},
 		}...)
 	}
 
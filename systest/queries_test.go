@@ -401,12 +401,30 @@ func SchemaQueryTestPredicate1(t *testing.T, c *dgo.Dgraph) {
 	  {
 		  "predicate": "dgraph.rule.permission"
 	  },
+	  {
+		  "predicate": "dgraph.rule.deny"
+	  },
+	  {
+		  "predicate": "dgraph.rule.filter"
+	  },
+	  {
+		  "predicate": "dgraph.group.default_permission"
+	  },
 	  {
         "predicate": "dgraph.graphql.schema"
 	  },
+	  {
+        "predicate": "dgraph.graphql.schema_updated_at"
+	  },
       {
         "predicate": "dgraph.user.group"
       },
+      {
+        "predicate": "dgraph.user.disabled"
+      },
+      {
+        "predicate": "dgraph.user.totp"
+      },
       {
         "predicate": "friends"
       },
@@ -17,6 +17,10 @@
 package types
 
 import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+
 	"golang.org/x/crypto/bcrypt"
 
 	"github.com/pkg/errors"
@@ -26,13 +30,72 @@ const (
 	pwdLenLimit = 6
 )
 
+// bcryptCost is the bcrypt cost that passwords are encrypted with.
+var bcryptCost = bcrypt.DefaultCost
+
+// SetBcryptCost sets the bcrypt cost that passwords are encrypted with from now on. Passwords
+// that are already stored keep the cost they were encrypted with.
+func SetBcryptCost(cost int) error {
+	if cost < bcrypt.MinCost || cost > bcrypt.MaxCost {
+		return errors.Errorf("bcrypt cost must be between %d and %d, but got %d",
+			bcrypt.MinCost, bcrypt.MaxCost, cost)
+	}
+	bcryptCost = cost
+	return nil
+}
+
+// BcryptCost returns the bcrypt cost that passwords are encrypted with.
+func BcryptCost() int {
+	return bcryptCost
+}
+
+// peppers are the server-side secrets that passwords are keyed with before they're encrypted.
+// Passwords are encrypted with the first one, and verified with each of them in turn, so that
+// passwords encrypted with an earlier pepper keep working until they're encrypted again.
+var peppers []string
+
+// SetPeppers sets the peppers that passwords are keyed with from now on. Passwords are encrypted
+// with the first pepper. The others are only used to verify passwords that were encrypted with
+// them. Passwords that were encrypted without a pepper can always be verified, so that a pepper
+// can be added to a cluster that already has passwords.
+func SetPeppers(p []string) error {
+	for _, pepper := range p {
+		if pepper == "" {
+			return errors.Errorf("peppers can't be empty")
+		}
+	}
+	peppers = p
+	return nil
+}
+
+// Peppered returns whether passwords are keyed with a pepper, in which case passwords that were
+// encrypted with an earlier pepper, or none, should be encrypted again with the current one.
+func Peppered() bool {
+	return len(peppers) > 0
+}
+
+// withPepper keys plain with pepper, unless pepper is empty. HMAC is used, rather than appending
+// the pepper to plain, because bcrypt ignores everything after the first 72 bytes.
+func withPepper(plain, pepper string) string {
+	if pepper == "" {
+		return plain
+	}
+	mac := hmac.New(sha256.New, []byte(pepper))
+	mac.Write([]byte(plain))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
 // Encrypt encrypts the given plain-text password.
 func Encrypt(plain string) (string, error) {
 	if len(plain) < pwdLenLimit {
 		return "", errors.Errorf("Password too short, i.e. should have at least 6 chars")
 	}
 
-	encrypted, err := bcrypt.GenerateFromPassword([]byte(plain), bcrypt.DefaultCost)
+	var pepper string
+	if len(peppers) > 0 {
+		pepper = peppers[0]
+	}
+	encrypted, err := bcrypt.GenerateFromPassword([]byte(withPepper(plain, pepper)), bcryptCost)
 	if err != nil {
 		return "", err
 	}
@@ -40,11 +103,40 @@ func Encrypt(plain string) (string, error) {
 	return string(encrypted), nil
 }
 
-// VerifyPassword checks that the plain-text password matches the encrypted password.
+// VerifyPassword checks that the plain-text password matches the encrypted password, which may
+// have been encrypted with any of the peppers or without one.
 func VerifyPassword(plain, encrypted string) error {
 	if len(plain) < pwdLenLimit || len(encrypted) == 0 {
 		return errors.Errorf("Invalid password/crypted string")
 	}
 
-	return bcrypt.CompareHashAndPassword([]byte(encrypted), []byte(plain))
+	// A copy is made, so that verifying passwords concurrently doesn't append to peppers.
+	candidates := make([]string, 0, len(peppers)+1)
+	candidates = append(append(candidates, peppers...), "")
+
+	var err error
+	for _, pepper := range candidates {
+		err = bcrypt.CompareHashAndPassword([]byte(encrypted), []byte(withPepper(plain, pepper)))
+		if err == nil {
+			return nil
+		}
+	}
+	return err
+}
+
+// NeedsRehash returns whether encrypted, which plain has been verified against, should be
+// encrypted again, because its bcrypt cost isn't the current one or it wasn't keyed with the
+// current pepper.
+func NeedsRehash(plain, encrypted string) bool {
+	cost, err := bcrypt.Cost([]byte(encrypted))
+	if err != nil || cost != bcryptCost {
+		return true
+	}
+
+	var pepper string
+	if len(peppers) > 0 {
+		pepper = peppers[0]
+	}
+	return bcrypt.CompareHashAndPassword([]byte(encrypted),
+		[]byte(withPepper(plain, pepper))) != nil
 }
@@ -16,7 +16,12 @@
 
 package types
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
 
 func TestEncrypt(t *testing.T) {
 	tests := []struct {
@@ -64,6 +69,27 @@ func TestEncrypt(t *testing.T) {
 	}
 }
 
+func TestBcryptCost(t *testing.T) {
+	defer func() {
+		require.NoError(t, SetBcryptCost(bcrypt.DefaultCost))
+	}()
+
+	require.Error(t, SetBcryptCost(bcrypt.MinCost-1))
+	require.Error(t, SetBcryptCost(bcrypt.MaxCost+1))
+	require.Equal(t, bcrypt.DefaultCost, BcryptCost())
+
+	require.NoError(t, SetBcryptCost(bcrypt.MinCost))
+	encrypted, err := Encrypt("123456")
+	require.NoError(t, err)
+	cost, err := bcrypt.Cost([]byte(encrypted))
+	require.NoError(t, err)
+	require.Equal(t, bcrypt.MinCost, cost)
+
+	// Passwords encrypted with a lower cost can still be verified.
+	require.NoError(t, SetBcryptCost(bcrypt.MinCost+2))
+	require.NoError(t, VerifyPassword("123456", encrypted))
+}
+
 func TestVerifyPassword(t *testing.T) {
 	type args struct {
 		plain     string
@@ -147,3 +173,61 @@ func TestVerifyPassword(t *testing.T) {
 		})
 	}
 }
+
+func TestPeppers(t *testing.T) {
+	defer func() {
+		require.NoError(t, SetPeppers(nil))
+	}()
+
+	require.Error(t, SetPeppers([]string{""}))
+
+	unpeppered, err := Encrypt("123456")
+	require.NoError(t, err)
+
+	require.NoError(t, SetPeppers([]string{"pepper1"}))
+	require.True(t, Peppered())
+	peppered, err := Encrypt("123456")
+	require.NoError(t, err)
+	require.NoError(t, VerifyPassword("123456", peppered))
+	// Passwords encrypted before a pepper was set can still be verified.
+	require.NoError(t, VerifyPassword("123456", unpeppered))
+
+	// After the pepper is rotated, passwords encrypted with the earlier one still work.
+	require.NoError(t, SetPeppers([]string{"pepper2", "pepper1"}))
+	require.NoError(t, VerifyPassword("123456", peppered))
+	require.Error(t, VerifyPassword("1234567", peppered))
+
+	// Without the pepper, the password can't be verified.
+	require.NoError(t, SetPeppers(nil))
+	require.False(t, Peppered())
+	require.Error(t, VerifyPassword("123456", peppered))
+	require.NoError(t, SetPeppers([]string{"pepper2"}))
+	require.Error(t, VerifyPassword("123456", peppered))
+}
+
+func TestNeedsRehash(t *testing.T) {
+	defer func() {
+		require.NoError(t, SetBcryptCost(bcrypt.DefaultCost))
+		require.NoError(t, SetPeppers(nil))
+	}()
+
+	encrypted, err := Encrypt("123456")
+	require.NoError(t, err)
+	require.False(t, NeedsRehash("123456", encrypted))
+
+	// A password encrypted with a different cost is encrypted again.
+	require.NoError(t, SetBcryptCost(bcrypt.MinCost))
+	require.True(t, NeedsRehash("123456", encrypted))
+	encrypted, err = Encrypt("123456")
+	require.NoError(t, err)
+	require.False(t, NeedsRehash("123456", encrypted))
+
+	// So is one that wasn't keyed with the current pepper.
+	require.NoError(t, SetPeppers([]string{"pepper1"}))
+	require.True(t, NeedsRehash("123456", encrypted))
+	encrypted, err = Encrypt("123456")
+	require.NoError(t, err)
+	require.False(t, NeedsRehash("123456", encrypted))
+	require.NoError(t, SetPeppers([]string{"pepper2", "pepper1"}))
+	require.True(t, NeedsRehash("123456", encrypted))
+}
@@ -19,6 +19,8 @@ package worker
 import (
 	"fmt"
 	"path/filepath"
+	"reflect"
+	"sort"
 	"time"
 
 	"github.com/golang/glog"
@@ -57,12 +59,43 @@ type Options struct {
 
 	// HmacSecret stores the secret used to sign JSON Web Tokens (JWT).
 	HmacSecret []byte
+	// AclSecretFile is the file the HMAC secret is read from, again when the secret is rotated.
+	AclSecretFile string
 	// AccessJwtTtl is the TTL for the access JWT.
 	AccessJwtTtl time.Duration
 	// RefreshJwtTtl is the TTL of the refresh JWT.
 	RefreshJwtTtl time.Duration
 	// AclRefreshInterval is the interval used to refresh the ACL cache.
 	AclRefreshInterval time.Duration
+	// AclCaseInsensitiveUsers makes user names case-insensitive, by storing them in lower case
+	// and lower casing the user name used to log in.
+	AclCaseInsensitiveUsers bool
+	// AclAuditFile is the file that ACL changes made through the admin API are appended to.
+	AclAuditFile string
+	// AclJwksUrl is the URL of the JSON Web Key Set of an external identity provider, whose JWTs
+	// can be used to log in.
+	AclJwksUrl string
+	// AclJwtGroupsClaim is the claim of the external JWTs that holds the ACL groups of the user.
+	AclJwtGroupsClaim string
+	// AclStrictRules makes the admin API reject rules for predicates that aren't in the schema.
+	AclStrictRules bool
+	// AclLoginRate is the number of password logins per minute allowed from an IP, after it has
+	// used up AclLoginBurst. Logins aren't rate limited if it's 0.
+	AclLoginRate int
+	// AclLoginBurst is the number of password logins an IP can make in a row.
+	AclLoginBurst int
+	// AclUserRequestRate is the number of queries and mutations per second allowed from a user,
+	// other than a guardian, after it has used up AclUserRequestBurst. Requests aren't rate
+	// limited if it's 0.
+	AclUserRequestRate int
+	// AclUserRequestBurst is the number of queries and mutations a user can make in a row.
+	AclUserRequestBurst int
+	// AclMaxStaleness is how long the ACL cache can go without being refreshed before requests
+	// are denied, except for the queries of guardians. The cache is never too stale if it's 0.
+	AclMaxStaleness time.Duration
+
+	// GraphqlSchemaURLHosts are the hosts the admin API can fetch GraphQL schemas from.
+	GraphqlSchemaURLHosts []string
 }
 
 // Config holds an instance of the server options..
@@ -77,9 +110,55 @@ func (opt *Options) String() string {
 
 	return fmt.Sprintf("{PostingDir:%s BadgerTables:%s BadgerVlog:%s WALDir:%s MutationsMode:%d "+
 		"AuthToken:%s AllottedMemory:%.1fMB AccessJwtTtl:%v RefreshJwtTtl:%v "+
-		"AclRefreshInterval:%v}", opt.PostingDir, opt.BadgerTables, opt.BadgerVlog, opt.WALDir,
+		"AclRefreshInterval:%v AclCaseInsensitiveUsers:%v AclAuditFile:%s AclJwksUrl:%s "+
+		"AclJwtGroupsClaim:%s AclStrictRules:%v AclSecretFile:%s AclLoginRate:%d AclLoginBurst:%d "+
+		"AclUserRequestRate:%d AclUserRequestBurst:%d AclMaxStaleness:%v "+
+		"GraphqlSchemaURLHosts:%v}",
+		opt.PostingDir, opt.BadgerTables, opt.BadgerVlog, opt.WALDir,
 		opt.MutationsMode, opt.AuthToken, opt.AllottedMemory, opt.AccessJwtTtl, opt.RefreshJwtTtl,
-		opt.AclRefreshInterval)
+		opt.AclRefreshInterval, opt.AclCaseInsensitiveUsers, opt.AclAuditFile, opt.AclJwksUrl,
+		opt.AclJwtGroupsClaim, opt.AclStrictRules, opt.AclSecretFile, opt.AclLoginRate,
+		opt.AclLoginBurst, opt.AclUserRequestRate, opt.AclUserRequestBurst, opt.AclMaxStaleness,
+		opt.GraphqlSchemaURLHosts)
+}
+
+// ConfigEntry is a setting of the running server.
+type ConfigEntry struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// secretSettings are the settings whose values are never reported by ConfigEntries.
+var secretSettings = map[string]bool{
+	"HmacSecret": true,
+	"AuthToken":  true,
+}
+
+// ConfigEntries returns the settings in Config and x.WorkerConfig, sorted by key. The values of
+// secret settings are redacted if they're set.
+func ConfigEntries() []ConfigEntry {
+	var entries []ConfigEntry
+	for _, opt := range []interface{}{Config, x.WorkerConfig} {
+		v := reflect.ValueOf(opt)
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+			if field.PkgPath != "" {
+				// Unexported fields aren't settings.
+				continue
+			}
+			key := field.Name
+			value := fmt.Sprintf("%v", v.Field(i).Interface())
+			// The secret settings are all strings or byte slices.
+			if secretSettings[key] && v.Field(i).Len() > 0 {
+				value = "<redacted>"
+			}
+			entries = append(entries, ConfigEntry{Key: key, Value: value})
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Key < entries[j].Key
+	})
+	return entries
 }
 
 // SetConfiguration sets the server configuration to the given config.
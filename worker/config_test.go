@@ -0,0 +1,45 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dgraph-io/dgraph/x"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigEntries(t *testing.T) {
+	oldConfig, oldWorkerConfig := Config, x.WorkerConfig
+	defer func() {
+		Config, x.WorkerConfig = oldConfig, oldWorkerConfig
+	}()
+	Config.AclRefreshInterval = 30 * time.Second
+	Config.HmacSecret = []byte("0123456789abcdef0123456789abcdef")
+	Config.AuthToken = ""
+	x.WorkerConfig.ExportPath = "/data/export"
+
+	entries := make(map[string]string)
+	for _, entry := range ConfigEntries() {
+		entries[entry.Key] = entry.Value
+	}
+	require.Equal(t, "30s", entries["AclRefreshInterval"])
+	require.Equal(t, "/data/export", entries["ExportPath"])
+	require.Equal(t, "<redacted>", entries["HmacSecret"])
+	require.Equal(t, "", entries["AuthToken"], "unset secrets can be reported as unset")
+}
@@ -386,8 +386,12 @@ func export(ctx context.Context, in *pb.ExportRequest) error {
 	}
 	glog.Infof("Running export for group %d at timestamp %d.", in.GroupId, in.ReadTs)
 
+	exportDir := x.WorkerConfig.ExportPath
+	if in.Destination != "" {
+		exportDir = in.Destination
+	}
 	uts := time.Unix(in.UnixTs, 0)
-	bdir := path.Join(x.WorkerConfig.ExportPath, fmt.Sprintf(
+	bdir := path.Join(exportDir, fmt.Sprintf(
 		"dgraph.r%d.u%s", in.ReadTs, uts.UTC().Format("0102.1504")))
 
 	if err := os.MkdirAll(bdir, 0700); err != nil {
@@ -612,8 +616,11 @@ func handleExportOverNetwork(ctx context.Context, in *pb.ExportRequest) error {
 	return err
 }
 
-// ExportOverNetwork sends export requests to all the known groups.
-func ExportOverNetwork(ctx context.Context, format string) error {
+// ExportOverNetwork sends export requests to all the known groups. The export is written to
+// destination on the leader of each group, or to the export directory if it's empty. If progress
+// isn't nil, it's called with the number of groups done every time the export of a group is done.
+func ExportOverNetwork(ctx context.Context, format, destination string,
+	progress func(done, total int)) error {
 	// If we haven't even had a single membership update, don't run export.
 	if err := x.HealthCheck(); err != nil {
 		glog.Errorf("Rejecting export request due to health check error: %v\n", err)
@@ -636,10 +643,11 @@ func ExportOverNetwork(ctx context.Context, format string) error {
 	for _, gid := range gids {
 		go func(group uint32) {
 			req := &pb.ExportRequest{
-				GroupId: group,
-				ReadTs:  readTs,
-				UnixTs:  time.Now().Unix(),
-				Format:  format,
+				GroupId:     group,
+				ReadTs:      readTs,
+				UnixTs:      time.Now().Unix(),
+				Format:      format,
+				Destination: destination,
 			}
 			ch <- handleExportOverNetwork(ctx, req)
 		}(gid)
@@ -652,6 +660,9 @@ func ExportOverNetwork(ctx context.Context, format string) error {
 			glog.Errorln(rerr)
 			return rerr
 		}
+		if progress != nil {
+			progress(i+1, len(gids))
+		}
 	}
 	glog.Infof("Export at readTs %d DONE", readTs)
 	return nil
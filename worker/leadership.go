@@ -0,0 +1,85 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/dgraph-io/dgo/v2/protos/api"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+)
+
+// TransferLeadership makes the alpha at address to the leader of group gid, and returns the
+// address of the leader once the transfer is done. It fails if the group has no leader, which is
+// the case when it doesn't have a quorum.
+func TransferLeadership(ctx context.Context, gid uint32, to string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+
+	var target *pb.Member
+	for _, m := range groups().members(gid) {
+		if m.Addr == to {
+			target = m
+		}
+	}
+	if target == nil {
+		return "", errors.Errorf("%s isn't a member of group %d", to, gid)
+	}
+
+	pl := groups().Leader(gid)
+	if pl == nil {
+		return "", errors.Errorf("group %d has no leader, it might not have a quorum", gid)
+	}
+	c := pb.NewWorkerClient(pl.Get())
+	if _, err := c.TransferLeadership(ctx, &pb.RaftContext{Group: gid, Id: target.Id}); err != nil {
+		return "", err
+	}
+	return target.Addr, nil
+}
+
+// TransferLeadership is run on the leader of a group, to make the member with the id in rc the
+// leader. It returns once the member is the leader.
+func (w *grpcWorker) TransferLeadership(ctx context.Context,
+	rc *pb.RaftContext) (*api.Payload, error) {
+	n := groups().Node
+	if n.gid != rc.Group {
+		return nil, errors.Errorf("this server serves group %d, not group %d", n.gid, rc.Group)
+	}
+	if !n.AmLeader() {
+		return nil, errors.Errorf("this server isn't the leader of group %d", rc.Group)
+	}
+	if rc.Id == n.Id {
+		return &api.Payload{}, nil
+	}
+
+	glog.Infof("Transferring leadership of group %d to %#x", rc.Group, rc.Id)
+	n.Raft().TransferLeadership(ctx, n.Id, rc.Id)
+
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+	for n.Raft().Status().Lead != rc.Id {
+		select {
+		case <-ctx.Done():
+			return nil, errors.Wrapf(ctx.Err(), "leadership wasn't transferred to %#x", rc.Id)
+		case <-ticker.C:
+		}
+	}
+	return &api.Payload{}, nil
+}
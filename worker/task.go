@@ -165,6 +165,26 @@ func ProcessTaskOverNetwork(ctx context.Context, q *pb.Query) (*pb.Result, error
 	return reply, nil
 }
 
+// StoredPassword returns the encrypted value of the password predicate attr of uid, which
+// queries never return. ok is false if this alpha doesn't serve attr, as the value can only be
+// read locally.
+func StoredPassword(attr string, uid uint64) (encrypted string, ok bool, err error) {
+	gid, err := groups().BelongsToReadOnly(attr, 0)
+	if err != nil || gid == 0 || !groups().ServesGroup(gid) {
+		return "", false, err
+	}
+
+	val, err := fetchValue(uid, attr, nil, types.PasswordID, posting.Oracle().MaxAssigned())
+	switch {
+	case err == posting.ErrNoValue:
+		return "", true, nil
+	case err != nil:
+		return "", false, err
+	}
+	encrypted, _ = val.Value.(string)
+	return encrypted, true, nil
+}
+
 // convertValue converts the data to the schema.State() type of predicate.
 func convertValue(attr, data string) (types.Val, error) {
 	// Parse given value and get token. There should be only one token.
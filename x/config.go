@@ -76,6 +76,13 @@ type WorkerOptions struct {
 	ProposedGroupId uint32
 	// StartTime is the start time of the alpha
 	StartTime time.Time
+	// AdminRequireAuth makes requests to /admin require a valid access JWT.
+	AdminRequireAuth bool
+	// AdminRequireClientCert makes requests to /admin require a verified TLS client certificate.
+	AdminRequireClientCert bool
+	// AdminReadOnly makes the /admin endpoints reject every mutation and schema update, while
+	// still serving queries and introspection.
+	AdminReadOnly bool
 }
 
 // WorkerConfig stores the global instance of the worker package's options.
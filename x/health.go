@@ -17,6 +17,7 @@
 package x
 
 import (
+	"sync"
 	"sync/atomic"
 
 	"github.com/pkg/errors"
@@ -28,11 +29,16 @@ var (
 	// mode is enabled
 	drainingMode uint32
 
+	// maintenanceMessage is the reason for the maintenance of the server given by its operators,
+	// which is reported in its health and in the errors of the mutations rejected while draining.
+	maintenanceMessage   string
+	maintenanceMessageMu sync.RWMutex
+
 	healthCheck     uint32
 	errHealth       = errors.New("Please retry again, server is not ready to accept requests")
 	errDrainingMode = errors.New("the server is in draining mode " +
-		"and client requests will only be allowed after exiting the mode " +
-		" by sending a POST request to /admin/draining?enable=false")
+		"and mutations will only be allowed after exiting the mode " +
+		"with the draining mutation of /admin")
 )
 
 // UpdateHealthStatus updates the server's health status so it can start accepting requests.
@@ -45,6 +51,26 @@ func UpdateDrainingMode(enable bool) {
 	setStatus(&drainingMode, enable)
 }
 
+// IsDraining returns whether the server is in draining mode.
+func IsDraining() bool {
+	return atomic.LoadUint32(&drainingMode) == 1
+}
+
+// SetMaintenanceMessage sets the maintenance message of the server. An empty msg clears it.
+func SetMaintenanceMessage(msg string) {
+	maintenanceMessageMu.Lock()
+	defer maintenanceMessageMu.Unlock()
+	maintenanceMessage = msg
+}
+
+// MaintenanceMessage returns the maintenance message of the server, or an empty string if none is
+// set.
+func MaintenanceMessage() string {
+	maintenanceMessageMu.RLock()
+	defer maintenanceMessageMu.RUnlock()
+	return maintenanceMessage
+}
+
 // HealthCheck returns whether the server is ready to accept requests or not
 // Load balancer would add the node to the endpoint once health check starts
 // returning true
@@ -52,7 +78,17 @@ func HealthCheck() error {
 	if atomic.LoadUint32(&healthCheck) == 0 {
 		return errHealth
 	}
-	if atomic.LoadUint32(&drainingMode) == 1 {
+	return nil
+}
+
+// DrainingCheck returns an error if the server is in draining mode. New mutations and schema
+// changes aren't accepted in draining mode, while reads and the commits of the transactions
+// already in flight are.
+func DrainingCheck() error {
+	if IsDraining() {
+		if msg := MaintenanceMessage(); msg != "" {
+			return errors.Wrapf(errDrainingMode, "%s", msg)
+		}
 		return errDrainingMode
 	}
 	return nil
@@ -19,6 +19,7 @@ package x
 import (
 	"encoding/binary"
 	"math"
+	"sort"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -554,16 +555,22 @@ var reservedPredicateMap = map[string]struct{}{
 }
 
 var aclPredicateMap = map[string]struct{}{
-	"dgraph.xid":             {},
-	"dgraph.password":        {},
-	"dgraph.user.group":      {},
-	"dgraph.rule.predicate":  {},
-	"dgraph.rule.permission": {},
-	"dgraph.acl.rule":        {},
+	"dgraph.xid":                      {},
+	"dgraph.password":                 {},
+	"dgraph.user.group":               {},
+	"dgraph.user.disabled":            {},
+	"dgraph.user.totp":                {},
+	"dgraph.rule.predicate":           {},
+	"dgraph.rule.permission":          {},
+	"dgraph.rule.deny":                {},
+	"dgraph.rule.filter":              {},
+	"dgraph.acl.rule":                 {},
+	"dgraph.group.default_permission": {},
 }
 
 var graphqlReservedPredicate = map[string]struct{}{
-	"dgraph.graphql.schema": {},
+	"dgraph.graphql.schema":            {},
+	"dgraph.graphql.schema_updated_at": {},
 }
 
 // internalPredicateMap stores a set of Dgraph's internal predicate. An internal
@@ -602,6 +609,20 @@ func ReservedPredicates() []string {
 	return preds
 }
 
+// AllReservedPredicates returns every predicate reserved by Dgraph, including those reserved
+// for ACL and GraphQL, in sorted order.
+func AllReservedPredicates() []string {
+	var preds []string
+	for _, predMap := range []map[string]struct{}{
+		reservedPredicateMap, aclPredicateMap, graphqlReservedPredicate} {
+		for pred := range predMap {
+			preds = append(preds, pred)
+		}
+	}
+	sort.Strings(preds)
+	return preds
+}
+
 func AllACLPredicates() []string {
 	preds := make([]string, 0, len(aclPredicateMap))
 	for pred := range aclPredicateMap {
@@ -52,6 +52,7 @@ import (
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/encoding/gzip"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 )
 
@@ -108,15 +109,23 @@ const (
 	GrootId = "groot"
 	// GuardiansId is the ID of the admin group for ACLs.
 	GuardiansId = "guardians"
+	// ReadonlyGuardiansId is the ID of the group whose members can read, but not modify, the
+	// ACL data.
+	ReadonlyGuardiansId = "readonly-guardians"
 	// AclPredicates is the JSON representation of the predicates reserved for use
 	// by the ACL system.
 	AclPredicates = `
 {"predicate":"dgraph.xid","type":"string", "index":true, "tokenizer":["exact"], "upsert":true},
 {"predicate":"dgraph.password","type":"password"},
 {"predicate":"dgraph.user.group","list":true, "reverse":true, "type":"uid"},
+{"predicate":"dgraph.user.disabled","type":"bool"},
+{"predicate":"dgraph.user.totp","type":"string"},
 {"predicate":"dgraph.acl.rule","type":"uid","list":true},
 {"predicate":"dgraph.rule.predicate","type":"string","index":true,"tokenizer":["exact"],"upsert":true},
-{"predicate":"dgraph.rule.permission","type":"int"}
+{"predicate":"dgraph.rule.permission","type":"int"},
+{"predicate":"dgraph.rule.deny","type":"bool"},
+{"predicate":"dgraph.rule.filter","type":"string"},
+{"predicate":"dgraph.group.default_permission","type":"int"}
 `
 	// GroupIdFileName is the name of the file storing the ID of the group to which
 	// the data in a postings directory belongs. This ID is used to join the proper
@@ -124,8 +133,9 @@ const (
 	// bulk load.
 	GroupIdFileName = "group_id"
 
-	// GraphqlPredicates is the json representation of the predicate reserved for graphql system.
-	GraphqlPredicates = `{"predicate":"dgraph.graphql.schema", "type": "string"}`
+	// GraphqlPredicates is the json representation of the predicates reserved for graphql system.
+	GraphqlPredicates = `{"predicate":"dgraph.graphql.schema", "type": "string"},
+{"predicate":"dgraph.graphql.schema_updated_at", "type": "datetime"}`
 )
 
 var (
@@ -340,6 +350,22 @@ func AttachAccessJwt(ctx context.Context, r *http.Request) context.Context {
 	return ctx
 }
 
+// AttachRemoteAddr adds the address of the client that made the HTTP request to the context as
+// its gRPC peer, so that it's known to the handlers gRPC requests and HTTP requests share.
+func AttachRemoteAddr(ctx context.Context, r *http.Request) context.Context {
+	if ip, port, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		if intPort, convErr := strconv.Atoi(port); convErr == nil {
+			ctx = peer.NewContext(ctx, &peer.Peer{
+				Addr: &net.TCPAddr{
+					IP:   net.ParseIP(ip),
+					Port: intPort,
+				},
+			})
+		}
+	}
+	return ctx
+}
+
 // Write response body, transparently compressing if necessary.
 func WriteResponse(w http.ResponseWriter, r *http.Request, b []byte) (int, error) {
 	var out io.Writer = w
@@ -851,6 +877,23 @@ func IsGuardian(groups []string) bool {
 	return false
 }
 
+// IsReadonlyGuardian returns true if groups contains the readonly guardians group.
+func IsReadonlyGuardian(groups []string) bool {
+	for _, group := range groups {
+		if group == ReadonlyGuardiansId {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsReservedGroup returns true if the group with the given ID is created by Dgraph and can't be
+// deleted.
+func IsReservedGroup(groupId string) bool {
+	return groupId == GuardiansId || groupId == ReadonlyGuardiansId
+}
+
 // RunVlogGC runs value log gc on store. It runs GC unconditionally after every 10 minutes.
 // Additionally it also runs GC if vLogSize has grown more than 1 GB in last minute.
 func RunVlogGC(store *badger.DB, closer *y.Closer) {